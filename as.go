@@ -0,0 +1,79 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.21
+// +build go1.21
+
+package grouperror
+
+import (
+	"errors"
+)
+
+/*
+AsAll returns all the errors from the given group that match the type T, see [errors.As].
+It preserves the order of [Collection].
+
+	for _, pathErr := range grouperror.AsAll[*os.PathError](err) {
+	    fmt.Println(pathErr.Path)
+	}
+
+AsAll requires Go 1.21 or later, because go.mod declares an older version of the language,
+and only Go 1.21+ toolchains let build constraints enable type parameters.
+See [AsAllInto] for older versions of Go.
+*/
+func AsAll[T error](err error) []T {
+	var result []T
+
+	eachLeaf(err, "", nil, func(l Leaf) bool {
+		var t T
+		if errors.As(l.Err, &t) {
+			result = append(result, t)
+		}
+
+		return true
+	})
+
+	return result
+}
+
+// Match is an error found by [AsAllEntries].
+type Match[T error] struct {
+	// Prefix is the accumulated prefix of all the groups the error belongs to.
+	Prefix string
+	// Err is the matched error.
+	Err T
+}
+
+// AsAllEntries works the same way as [AsAll], but it also returns the accumulated prefix of each error.
+func AsAllEntries[T error](err error) []Match[T] {
+	var result []Match[T]
+
+	eachLeaf(err, "", nil, func(l Leaf) bool {
+		var t T
+		if errors.As(l.Err, &t) {
+			result = append(result, Match[T]{Prefix: l.Prefix, Err: t})
+		}
+
+		return true
+	})
+
+	return result
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"errors"
+	"reflect"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+/*
+AsAllInto finds all the errors from the given group that match the element type of the given slice, see [errors.As].
+It appends them to the slice, and returns their accumulated prefixes. It preserves the order of [Collection].
+It panics if target is not a non-nil pointer to a slice of a type that implements error, or of any interface type.
+
+	var pathErrs []*os.PathError
+	prefixes := grouperror.AsAllInto(err, &pathErrs)
+	for i, pathErr := range pathErrs {
+	    fmt.Println(prefixes[i], pathErr.Path)
+	}
+
+See [AsAll] for the generic version, available in Go 1.21+.
+*/
+func AsAllInto(err error, target any) []string {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Slice {
+		panic("grouperror: target must be a non-nil pointer to a slice")
+	}
+
+	s := v.Elem()
+	t := s.Type().Elem()
+
+	if t.Kind() != reflect.Interface && !t.Implements(errorType) {
+		panic("grouperror: *target must be a slice of interface or a type that implements error")
+	}
+
+	var prefixes []string
+
+	eachLeaf(err, "", nil, func(l Leaf) bool {
+		p := reflect.New(t)
+		if errors.As(l.Err, p.Interface()) {
+			s = reflect.Append(s, p.Elem())
+			prefixes = append(prefixes, l.Prefix)
+		}
+
+		return true
+	})
+
+	v.Elem().Set(s)
+
+	return prefixes
+}
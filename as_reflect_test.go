@@ -0,0 +1,110 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type customError struct{}
+
+func (*customError) Error() string {
+	return "custom error"
+}
+
+func newPathError(name string) error {
+	_, err := os.Open(name)
+
+	return err //nolint:wrapcheck
+}
+
+func TestAsAllInto(t *testing.T) {
+	t.Parallel()
+
+	err := grouperror.Join(
+		grouperror.Prefix(
+			"my group: ",
+			newPathError("file1"),
+			io.EOF,
+			grouperror.Prefix("nested: ", fmt.Errorf("wrapped: %w", newPathError("file2"))),
+		),
+		io.ErrUnexpectedEOF,
+		newPathError("file3"),
+	)
+
+	t.Run("Pointers", func(t *testing.T) {
+		t.Parallel()
+
+		var pathErrs []*os.PathError
+		prefixes := grouperror.AsAllInto(err, &pathErrs)
+
+		assert.Equal(t, []string{"my group: ", "my group: nested: ", ""}, prefixes)
+		require.Len(t, pathErrs, 3)
+		assert.Equal(t, "file1", pathErrs[0].Path)
+		assert.Equal(t, "file2", pathErrs[1].Path)
+		assert.Equal(t, "file3", pathErrs[2].Path)
+	})
+
+	t.Run("Interfaces", func(t *testing.T) {
+		t.Parallel()
+
+		errs := []error{io.ErrClosedPipe}
+		prefixes := grouperror.AsAllInto(err, &errs)
+
+		assert.Len(t, prefixes, 5)
+		assert.Len(t, errs, 6)
+		assert.Same(t, io.ErrClosedPipe, errs[0])
+		assert.Same(t, io.EOF, errs[2])
+	})
+
+	t.Run("No matches", func(t *testing.T) {
+		t.Parallel()
+
+		var customErrs []*customError
+		assert.Nil(t, grouperror.AsAllInto(err, &customErrs))
+		assert.Nil(t, customErrs)
+	})
+
+	t.Run("Invalid target", func(t *testing.T) {
+		t.Parallel()
+
+		assert.PanicsWithValue(t, "grouperror: target must be a non-nil pointer to a slice", func() {
+			var pathErrs []*os.PathError
+			grouperror.AsAllInto(err, pathErrs)
+		})
+
+		assert.PanicsWithValue(t, "grouperror: target must be a non-nil pointer to a slice", func() {
+			grouperror.AsAllInto(err, (*[]error)(nil))
+		})
+
+		assert.PanicsWithValue(t, "grouperror: *target must be a slice of interface or a type that implements error", func() {
+			var strs []string
+			grouperror.AsAllInto(err, &strs)
+		})
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.21
+// +build go1.21
+
+package grouperror_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsAll(t *testing.T) {
+	t.Parallel()
+
+	err := grouperror.Join(
+		grouperror.Prefix(
+			"my group: ",
+			newPathError("file1"),
+			io.EOF,
+			grouperror.Prefix("nested: ", fmt.Errorf("wrapped: %w", newPathError("file2"))),
+		),
+		io.ErrUnexpectedEOF,
+		newPathError("file3"),
+	)
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, grouperror.AsAll[*os.PathError](nil))
+		assert.Nil(t, grouperror.AsAllEntries[*os.PathError](nil))
+	})
+
+	t.Run("No matches", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, grouperror.AsAll[*customError](err))
+	})
+
+	t.Run("AsAll", func(t *testing.T) {
+		t.Parallel()
+
+		pathErrs := grouperror.AsAll[*os.PathError](err)
+		require.Len(t, pathErrs, 3)
+		assert.Equal(t, "file1", pathErrs[0].Path)
+		assert.Equal(t, "file2", pathErrs[1].Path)
+		assert.Equal(t, "file3", pathErrs[2].Path)
+
+		assert.Len(t, grouperror.AsAll[error](err), 5)
+	})
+
+	t.Run("AsAllEntries", func(t *testing.T) {
+		t.Parallel()
+
+		entries := grouperror.AsAllEntries[*os.PathError](err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, "my group: ", entries[0].Prefix)
+		assert.Equal(t, "file1", entries[0].Err.Path)
+		assert.Equal(t, "my group: nested: ", entries[1].Prefix)
+		assert.Equal(t, "file2", entries[1].Err.Path)
+		assert.Equal(t, "", entries[2].Prefix)
+		assert.Equal(t, "file3", entries[2].Err.Path)
+
+		assert.Equal(t, []grouperror.Match[error]{{Err: io.EOF}}, grouperror.AsAllEntries[error](io.EOF))
+	})
+}
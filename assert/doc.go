@@ -26,4 +26,5 @@
 //	}
 //
 // This package requires https://github.com/stretchr/testify.
+// See [github.com/gontainer/grouperror/grouperrortest] for the equivalent based only on the standard library.
 package assert
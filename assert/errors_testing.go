@@ -21,6 +21,10 @@
 package assert
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
 	"github.com/gontainer/grouperror"
 	"github.com/stretchr/testify/assert"
 )
@@ -45,18 +49,17 @@ func minLen(a []error, b []string) int {
 // It asserts the given error is equal to nil whenever `len(msgs) == 0`.
 //
 // See [grouperror.Collection].
-func EqualErrorGroup(t testingT, err error, msgs []string) {
+func EqualErrorGroup(t testingT, err error, msgs []string) bool {
 	if len(msgs) == 0 {
-		assert.NoError(t, err) //nolint:testifylint
-
-		return
+		return assert.NoError(t, err) //nolint:testifylint
 	}
 
 	errs := grouperror.Collection(err)
 	l := minLen(errs, msgs)
+	ok := true
 
 	for i := 0; i < l; i++ {
-		assert.EqualError(t, errs[i], msgs[i]) //nolint:testifylint
+		ok = assert.EqualError(t, errs[i], msgs[i]) && ok //nolint:testifylint
 	}
 
 	var extra []any
@@ -64,5 +67,142 @@ func EqualErrorGroup(t testingT, err error, msgs []string) {
 		extra = []any{err.Error()}
 	}
 
-	assert.Len(t, errs, len(msgs), extra...)
+	return assert.Len(t, errs, len(msgs), extra...) && ok
+}
+
+func messages(err error) []string {
+	errs := grouperror.Collection(err)
+	msgs := make([]string, 0, len(errs))
+
+	for _, x := range errs {
+		msgs = append(msgs, x.Error())
+	}
+
+	return msgs
+}
+
+// diffMessages returns the expected messages that have not been found,
+// and the actual messages that have not been expected.
+func diffMessages(expected []string, actual []string) (missing []string, unexpected []string) {
+	counts := make(map[string]int, len(actual))
+	for _, m := range actual {
+		counts[m]++
+	}
+
+	for _, m := range expected {
+		if counts[m] > 0 {
+			counts[m]--
+
+			continue
+		}
+
+		missing = append(missing, m)
+	}
+
+	for _, m := range actual {
+		if counts[m] > 0 {
+			counts[m]--
+
+			unexpected = append(unexpected, m)
+		}
+	}
+
+	return missing, unexpected
+}
+
+func formatDiff(missing []string, unexpected []string) string {
+	var b strings.Builder
+
+	b.WriteString("Error groups do not match")
+
+	if len(missing) > 0 {
+		b.WriteString("\nmissing:")
+
+		for _, m := range missing {
+			_, _ = fmt.Fprintf(&b, "\n\t- %q", m)
+		}
+	}
+
+	if len(unexpected) > 0 {
+		b.WriteString("\nunexpected:")
+
+		for _, m := range unexpected {
+			_, _ = fmt.Fprintf(&b, "\n\t+ %q", m)
+		}
+	}
+
+	return b.String()
+}
+
+// ElementsMatchErrorGroup asserts that the given error is a group of errors with the following messages,
+// ignoring the order of the errors.
+// It asserts the given error is equal to nil whenever `len(msgs) == 0`.
+//
+// See [grouperror.Collection].
+func ElementsMatchErrorGroup(t testingT, err error, msgs []string) bool {
+	if len(msgs) == 0 {
+		return assert.NoError(t, err) //nolint:testifylint
+	}
+
+	missing, unexpected := diffMessages(msgs, messages(err))
+	if len(missing) == 0 && len(unexpected) == 0 {
+		return true
+	}
+
+	return assert.Fail(t, formatDiff(missing, unexpected))
+}
+
+// ContainsErrorGroup asserts that the given error is a group of errors that contains the following messages,
+// ignoring the order of the errors.
+//
+// See [grouperror.Collection].
+func ContainsErrorGroup(t testingT, err error, msgs []string) bool {
+	missing, _ := diffMessages(msgs, messages(err))
+	if len(missing) == 0 {
+		return true
+	}
+
+	return assert.Fail(t, formatDiff(missing, nil))
+}
+
+// RegexpErrorGroup asserts that the given error is a group of errors with messages that match
+// the following regular expressions, respectively.
+// It asserts the given error is equal to nil whenever `len(patterns) == 0`.
+//
+// See [grouperror.Collection].
+func RegexpErrorGroup(t testingT, err error, patterns []string) bool {
+	if len(patterns) == 0 {
+		return assert.NoError(t, err) //nolint:testifylint
+	}
+
+	actual := messages(err)
+
+	var missing, unexpected []string
+
+	for i, p := range patterns {
+		r, compileErr := regexp.Compile(p)
+		if compileErr != nil {
+			return assert.Fail(t, fmt.Sprintf("Invalid regular expression %q: %s", p, compileErr.Error()))
+		}
+
+		if i < len(actual) && r.MatchString(actual[i]) {
+			continue
+		}
+
+		missing = append(missing, p)
+
+		if i < len(actual) {
+			unexpected = append(unexpected, actual[i])
+		}
+	}
+
+	if len(actual) > len(patterns) {
+		unexpected = append(unexpected, actual[len(patterns):]...)
+	}
+
+	if len(missing) == 0 && len(unexpected) == 0 {
+		return true
+	}
+
+	return assert.Fail(t, formatDiff(missing, unexpected))
 }
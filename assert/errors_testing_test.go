@@ -48,7 +48,7 @@ func TestEqualErrorGroup(t *testing.T) {
 		t.Parallel()
 
 		mt := new(mockTesting)
-		errAssert.EqualErrorGroup(mt, nil, nil)
+		assert.True(t, errAssert.EqualErrorGroup(mt, nil, nil))
 		assert.Empty(t, mt.String())
 	})
 
@@ -56,7 +56,7 @@ func TestEqualErrorGroup(t *testing.T) {
 		t.Parallel()
 
 		mt := new(mockTesting)
-		errAssert.EqualErrorGroup(mt, os.ErrClosed, nil)
+		assert.False(t, errAssert.EqualErrorGroup(mt, os.ErrClosed, nil))
 		require.Equal(
 			t,
 			`
@@ -228,3 +228,205 @@ func TestEqualErrorGroup(t *testing.T) {
 		)
 	})
 }
+
+func TestElementsMatchErrorGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("No errors [OK]", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.True(t, errAssert.ElementsMatchErrorGroup(mt, nil, nil))
+		assert.Empty(t, mt.String())
+	})
+
+	t.Run("No errors [error]", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.False(t, errAssert.ElementsMatchErrorGroup(mt, os.ErrClosed, nil))
+		assert.Equal(
+			t,
+			`
+	Error Trace:	
+	Error:      	Received unexpected error:
+	            	file already closed
+`,
+			mt.String(),
+		)
+	})
+
+	t.Run("Equal errors [OK]", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.True(t, errAssert.ElementsMatchErrorGroup(
+			mt,
+			grouperror.Join(os.ErrClosed, os.ErrExist, os.ErrClosed),
+			[]string{
+				"file already exists",
+				"file already closed",
+				"file already closed",
+			},
+		))
+		assert.Empty(t, mt.String())
+	})
+
+	t.Run("Equal errors [error]", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.False(t, errAssert.ElementsMatchErrorGroup(
+			mt,
+			grouperror.Join(os.ErrClosed, os.ErrExist, os.ErrExist),
+			[]string{
+				"file already exists",
+				"invalid argument",
+				"file already closed",
+				"file already closed",
+			},
+		))
+		assert.Equal(
+			t,
+			`
+	Error Trace:	
+	Error:      	Error groups do not match
+	            	missing:
+	            		- "invalid argument"
+	            		- "file already closed"
+	            	unexpected:
+	            		+ "file already exists"
+`,
+			mt.String(),
+		)
+	})
+}
+
+func TestContainsErrorGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.True(t, errAssert.ContainsErrorGroup(
+			mt,
+			grouperror.Join(os.ErrClosed, os.ErrExist, os.ErrInvalid),
+			[]string{
+				"invalid argument",
+				"file already closed",
+			},
+		))
+		assert.True(t, errAssert.ContainsErrorGroup(mt, nil, nil))
+		assert.Empty(t, mt.String())
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.False(t, errAssert.ContainsErrorGroup(
+			mt,
+			grouperror.Join(os.ErrClosed, os.ErrExist),
+			[]string{
+				"invalid argument",
+				"file already closed",
+				"file already closed",
+			},
+		))
+		assert.Equal(
+			t,
+			`
+	Error Trace:	
+	Error:      	Error groups do not match
+	            	missing:
+	            		- "invalid argument"
+	            		- "file already closed"
+`,
+			mt.String(),
+		)
+	})
+}
+
+func TestRegexpErrorGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("No errors [OK]", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.True(t, errAssert.RegexpErrorGroup(mt, nil, nil))
+		assert.Empty(t, mt.String())
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.True(t, errAssert.RegexpErrorGroup(
+			mt,
+			grouperror.Prefix("task #3: ", os.ErrClosed, os.ErrExist),
+			[]string{
+				`^task #\d+: file already closed$`,
+				`already exists`,
+			},
+		))
+		assert.Empty(t, mt.String())
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.False(t, errAssert.RegexpErrorGroup(
+			mt,
+			grouperror.Join(os.ErrClosed, os.ErrExist, os.ErrInvalid),
+			[]string{
+				`closed$`,
+				`^invalid`,
+			},
+		))
+		assert.Equal(
+			t,
+			`
+	Error Trace:	
+	Error:      	Error groups do not match
+	            	missing:
+	            		- "^invalid"
+	            	unexpected:
+	            		+ "file already exists"
+	            		+ "invalid argument"
+`,
+			mt.String(),
+		)
+	})
+
+	t.Run("Too few errors", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.False(t, errAssert.RegexpErrorGroup(mt, grouperror.Join(os.ErrClosed), []string{`closed`, `exists`}))
+		assert.Equal(
+			t,
+			`
+	Error Trace:	
+	Error:      	Error groups do not match
+	            	missing:
+	            		- "exists"
+`,
+			mt.String(),
+		)
+	})
+
+	t.Run("Invalid regexp", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.False(t, errAssert.RegexpErrorGroup(mt, os.ErrClosed, []string{`(`}))
+		assert.Equal(
+			t,
+			"\n\tError Trace:\t\n\tError:      \tInvalid regular expression \"(\": error parsing regexp: missing closing ): `(`\n",
+			mt.String(),
+		)
+	})
+}
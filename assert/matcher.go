@@ -0,0 +1,132 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+)
+
+// LeafMatcher checks a single error of a group, see [MatchErrorGroup].
+type LeafMatcher struct {
+	desc  string
+	match func(error) bool
+}
+
+// String returns the description of the matcher.
+func (m LeafMatcher) String() string {
+	return m.desc
+}
+
+// LeafMessage matches errors with the given message.
+func LeafMessage(msg string) LeafMatcher {
+	return LeafMatcher{
+		desc: fmt.Sprintf("message %q", msg),
+		match: func(err error) bool {
+			return err.Error() == msg
+		},
+	}
+}
+
+// LeafIs matches errors that satisfy [errors.Is] for the given target.
+func LeafIs(target error) LeafMatcher {
+	return LeafMatcher{
+		desc: fmt.Sprintf("errors.Is(%q)", fmt.Sprint(target)),
+		match: func(err error) bool {
+			return errors.Is(err, target)
+		},
+	}
+}
+
+// LeafAs matches errors that satisfy [errors.As] for the given target.
+// The target must be a non-nil pointer to either a type that implements error, or to any interface type.
+func LeafAs(target any) LeafMatcher {
+	desc := fmt.Sprintf("errors.As(%T)", target)
+	if t := reflect.TypeOf(target); t != nil && t.Kind() == reflect.Ptr {
+		desc = fmt.Sprintf("errors.As(%s)", t.Elem())
+	}
+
+	return LeafMatcher{
+		desc: desc,
+		match: func(err error) bool {
+			return errors.As(err, target)
+		},
+	}
+}
+
+// LeafFunc matches errors that satisfy the given predicate. The description is used in failure messages.
+func LeafFunc(desc string, fn func(error) bool) LeafMatcher {
+	return LeafMatcher{
+		desc:  desc,
+		match: fn,
+	}
+}
+
+/*
+MatchErrorGroup asserts that the given error is a group of errors that satisfy the following matchers, respectively.
+It asserts the given error is equal to nil whenever `len(matchers) == 0`.
+
+	errAssert.MatchErrorGroup(
+		t,
+		err,
+		errAssert.LeafMessage("could not read config"),
+		errAssert.LeafIs(io.ErrUnexpectedEOF),
+		errAssert.LeafAs(new(*os.PathError)),
+	)
+
+See [grouperror.Collection].
+*/
+func MatchErrorGroup(t testingT, err error, matchers ...LeafMatcher) bool {
+	if len(matchers) == 0 {
+		return assert.NoError(t, err) //nolint:testifylint
+	}
+
+	errs := grouperror.Collection(err)
+
+	var failures []string
+
+	for i, m := range matchers {
+		if i >= len(errs) {
+			failures = append(failures, fmt.Sprintf("\t[%d] missing error that matches %s", i, m))
+
+			continue
+		}
+
+		if !m.match(errs[i]) {
+			failures = append(failures, fmt.Sprintf("\t[%d] %q does not match %s", i, errs[i].Error(), m))
+		}
+	}
+
+	for i := len(matchers); i < len(errs); i++ {
+		failures = append(failures, fmt.Sprintf("\t[%d] unexpected error %q", i, errs[i].Error()))
+	}
+
+	if len(failures) == 0 {
+		return true
+	}
+
+	return assert.Fail(t, "Error group does not match\n"+strings.Join(failures, "\n"))
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package assert_test
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	errAssert "github.com/gontainer/grouperror/assert"
+	"github.com/stretchr/testify/assert"
+)
+
+type customError struct{}
+
+func (*customError) Error() string {
+	return "custom error"
+}
+
+func TestMatchErrorGroup(t *testing.T) {
+	t.Parallel()
+
+	_, pathErr := os.Open("file does not exist")
+	err := grouperror.Prefix("my group: ", io.EOF, pathErr, io.ErrUnexpectedEOF)
+
+	t.Run("No errors [OK]", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.True(t, errAssert.MatchErrorGroup(mt, nil))
+		assert.Empty(t, mt.String())
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		var target *os.PathError
+
+		mt := new(mockTesting)
+		assert.True(t, errAssert.MatchErrorGroup(
+			mt,
+			err,
+			errAssert.LeafMessage("my group: EOF"),
+			errAssert.LeafAs(&target),
+			errAssert.LeafFunc("prefixed", func(err error) bool {
+				return strings.HasPrefix(err.Error(), "my group: ")
+			}),
+		))
+		assert.Empty(t, mt.String())
+		assert.Equal(t, "file does not exist", target.Path)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		var target *customError
+
+		mt := new(mockTesting)
+		assert.False(t, errAssert.MatchErrorGroup(
+			mt,
+			err,
+			errAssert.LeafIs(io.EOF),
+			errAssert.LeafAs(&target),
+		))
+		assert.Equal(
+			t,
+			"\n\tError Trace:\t\n\tError:      \tError group does not match\n"+
+				"\t            \t\t[1] \"my group: open file does not exist: no such file or directory\" "+
+				"does not match errors.As(*assert_test.customError)\n"+
+				"\t            \t\t[2] unexpected error \"my group: unexpected EOF\"\n",
+			mt.String(),
+		)
+	})
+
+	t.Run("Missing errors", func(t *testing.T) {
+		t.Parallel()
+
+		mt := new(mockTesting)
+		assert.False(t, errAssert.MatchErrorGroup(
+			mt,
+			grouperror.Join(io.EOF),
+			errAssert.LeafMessage("unexpected EOF"),
+			errAssert.LeafIs(io.ErrUnexpectedEOF),
+		))
+		assert.Equal(
+			t,
+			"\n\tError Trace:\t\n\tError:      \tError group does not match\n"+
+				"\t            \t\t[0] \"EOF\" does not match message \"unexpected EOF\"\n"+
+				"\t            \t\t[1] missing error that matches errors.Is(\"unexpected EOF\")\n",
+			mt.String(),
+		)
+	})
+}
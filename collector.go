@@ -0,0 +1,111 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"fmt"
+	"sync"
+)
+
+/*
+Collector accumulates errors and joins them into a group. It is safe for concurrent use.
+The zero value is ready to use, use [NewCollector] to customize it.
+
+	var c grouperror.Collector
+	c.Add(errors.New("error1"))
+	c.Add(nil) // nil-errors are being ignored
+	c.Addf("error%d", 2)
+	err := c.Err() // the same as grouperror.Join(errors.New("error1"), errors.New("error2"))
+
+See [Join].
+*/
+type Collector struct {
+	mu     sync.Mutex
+	errors []error
+	dedup  func(error) error
+}
+
+// CollectorOption configures a [Collector].
+type CollectorOption func(*Collector)
+
+// WithDedup merges the collected errors the same way as [Dedup].
+func WithDedup() CollectorOption {
+	return WithDedupFunc(nil)
+}
+
+// WithDedupFunc merges the collected errors the same way as [DedupFunc].
+func WithDedupFunc(key func(Leaf) string) CollectorOption {
+	return func(c *Collector) {
+		c.dedup = func(err error) error {
+			return DedupFunc(err, key)
+		}
+	}
+}
+
+// NewCollector returns a new [Collector] configured by the given options.
+func NewCollector(opts ...CollectorOption) *Collector {
+	c := &Collector{}
+
+	for _, o := range opts {
+		o(c)
+	}
+
+	return c
+}
+
+// Add adds the given error to the collection. It ignores nil-values.
+func (c *Collector) Add(err error) {
+	if err == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.errors = append(c.errors, err)
+}
+
+// Addf formats an error according to a format specifier using [fmt.Errorf] and adds it to the collection.
+func (c *Collector) Addf(format string, args ...any) {
+	c.Add(fmt.Errorf(format, args...)) //nolint:goerr113
+}
+
+// Len returns the number of collected errors.
+func (c *Collector) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.errors)
+}
+
+// Err joins the collected errors the same way as [Join].
+// It returns nil, when there are no errors collected.
+func (c *Collector) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := Join(c.errors...)
+	if err != nil && c.dedup != nil {
+		err = c.dedup(err)
+	}
+
+	return err
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:goerr113
+func TestCollector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+
+		var c grouperror.Collector
+		c.Add(nil)
+		assert.Equal(t, 0, c.Len())
+		require.NoError(t, c.Err())
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		var c grouperror.Collector
+		c.Add(io.EOF)
+		c.Add(nil)
+		c.Add(grouperror.Prefix("my group: ", errors.New("error1"), errors.New("error2")))
+		c.Addf("could not open file: %w", os.ErrNotExist)
+
+		assert.Equal(t, 3, c.Len())
+
+		err := c.Err()
+		expected := []string{
+			"EOF",
+			"my group: error1",
+			"my group: error2",
+			"could not open file: file does not exist",
+		}
+
+		errs := grouperror.Collection(err)
+		require.Len(t, errs, len(expected))
+		for i, x := range errs {
+			assert.EqualError(t, x, expected[i]) //nolint:testifylint
+		}
+
+		assert.ErrorIs(t, err, io.EOF)              //nolint:testifylint
+		assert.ErrorIs(t, err, os.ErrNotExist)      //nolint:testifylint
+		assert.NotErrorIs(t, err, io.ErrClosedPipe) //nolint:testifylint
+	})
+
+	t.Run("Err returns a snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		var c grouperror.Collector
+		c.Add(io.EOF)
+		err := c.Err()
+		c.Add(io.ErrUnexpectedEOF)
+
+		assert.Len(t, grouperror.Collection(err), 1)
+		assert.Len(t, grouperror.Collection(c.Err()), 2)
+	})
+
+	t.Run("Concurrency", func(t *testing.T) {
+		t.Parallel()
+
+		const max = 100
+
+		var (
+			c  grouperror.Collector
+			wg sync.WaitGroup
+		)
+
+		for i := 0; i < max; i++ {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+
+				c.Addf("error #%d", i)
+			}(i)
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, max, c.Len())
+		assert.Len(t, grouperror.Collection(c.Err()), max)
+	})
+
+	t.Run("WithDedup", func(t *testing.T) {
+		t.Parallel()
+
+		c := grouperror.NewCollector(grouperror.WithDedup())
+		for i := 0; i < 3; i++ {
+			c.Add(io.EOF)
+			c.Addf("error #%d", i%2)
+		}
+
+		assert.Equal(t, 6, c.Len())
+		assertMessages(t, c.Err(), []string{
+			"EOF (x3)",
+			"error #0 (x2)",
+			"error #1",
+		})
+	})
+
+	t.Run("WithDedupFunc", func(t *testing.T) {
+		t.Parallel()
+
+		c := grouperror.NewCollector(grouperror.WithDedupFunc(func(grouperror.Leaf) string {
+			return ""
+		}))
+		require.NoError(t, c.Err())
+
+		c.Add(io.EOF)
+		c.Add(io.ErrUnexpectedEOF)
+		assertMessages(t, c.Err(), []string{
+			"EOF (x2)",
+		})
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Dedup merges errors with equal messages, see [DedupFunc].
+//
+//	err := grouperror.Prefix("my group: ", errConnRefused, errConnRefused, io.EOF, errConnRefused)
+//	fmt.Println(grouperror.Dedup(err))
+//	// Output:
+//	// my group: connection refused (x3)
+//	// my group: EOF
+func Dedup(err error) error {
+	return DedupFunc(err, nil)
+}
+
+/*
+DedupFunc merges errors from the given group that have equal keys. By default, the key is the message
+of the corresponding element of [Collection], i.e. `leaf.Prefix + leaf.Err.Error()`.
+It preserves the order of the first occurrences, and the merged errors keep the prefix and the path
+of the first occurrence. The message of a merged error contains the number of occurrences, e.g.
+
+	connection refused (x312)
+
+[errors.Is] and [errors.As] match any of the merged errors.
+The merged errors implement `interface{ Count() int }`.
+The result is a flat group, so only the outermost limit is kept, see [Limit].
+*/
+func DedupFunc(err error, key func(Leaf) string) error {
+	if l, ok := err.(*limitError); ok { //nolint:errorlint
+		return Limit(DedupFunc(l.err, key), l.n)
+	}
+
+	if _, ok := groupOf(err); !ok {
+		return err
+	}
+
+	if key == nil {
+		key = func(l Leaf) string {
+			return l.Prefix + l.Err.Error()
+		}
+	}
+
+	var (
+		index   = make(map[string]int)
+		entries []*duplicateError
+		firsts  []Leaf
+	)
+
+	eachLeaf(err, "", nil, func(l Leaf) bool {
+		k := key(l)
+
+		if i, ok := index[k]; ok {
+			entries[i].errors = append(entries[i].errors, l.Err)
+
+			return true
+		}
+
+		index[k] = len(entries)
+		entries = append(entries, &duplicateError{errors: []error{l.Err}})
+		firsts = append(firsts, l)
+
+		return true
+	})
+
+	errs := make([]error, 0, len(entries))
+
+	for i, e := range entries {
+		var x error = e
+		if len(e.errors) == 1 {
+			x = e.errors[0]
+		}
+
+		if l := firsts[i]; l.Prefix != "" || len(l.Path) > 0 {
+			x = &groupError{
+				prefix: l.Prefix,
+				path:   l.Path,
+				errors: []error{x},
+			}
+		}
+
+		errs = append(errs, x)
+	}
+
+	return &groupError{
+		errors: errs,
+	}
+}
+
+// duplicateError represents errors merged by [DedupFunc].
+type duplicateError struct {
+	errors []error
+}
+
+func (d *duplicateError) Error() string {
+	return d.errors[0].Error() + " (x" + strconv.Itoa(len(d.errors)) + ")"
+}
+
+// Count returns the number of merged errors.
+func (d *duplicateError) Count() int {
+	return len(d.errors)
+}
+
+// Severity returns [SeverityWarning] when all the merged errors are warnings.
+// Otherwise, it returns [SeverityError], so merging a warning with an error never hides the error.
+func (d *duplicateError) Severity() Severity {
+	for _, err := range d.errors {
+		if SeverityOf(err) != SeverityWarning {
+			return SeverityError
+		}
+	}
+
+	return SeverityWarning
+}
+
+// Is provides support for [errors.Is].
+func (d *duplicateError) Is(target error) bool {
+	for _, err := range d.errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As provides support for [errors.As].
+func (d *duplicateError) As(target interface{}) bool {
+	for _, err := range d.errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func assertMessages(t *testing.T, err error, expected []string) {
+	t.Helper()
+
+	errs := grouperror.Collection(err)
+	require.Len(t, errs, len(expected), err)
+
+	for i, x := range errs {
+		assert.EqualError(t, x, expected[i]) //nolint:testifylint
+	}
+}
+
+//nolint:goerr113
+func TestDedup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, grouperror.Dedup(nil))
+	})
+
+	t.Run("Single error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Same(t, io.EOF, grouperror.Dedup(io.EOF))
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		_, pathErr := os.Open("file does not exist")
+		errConnRefused := errors.New("connection refused")
+
+		err := grouperror.Prefix(
+			"my group: ",
+			errConnRefused,
+			errors.New("connection refused"),
+			io.EOF,
+			grouperror.Join(pathErr, errConnRefused),
+			grouperror.Prefix("other: ", errConnRefused),
+		)
+		err = grouperror.Join(err, io.EOF)
+
+		deduped := grouperror.Dedup(err)
+		assertMessages(t, deduped, []string{
+			"my group: connection refused (x3)",
+			"my group: EOF",
+			"my group: open file does not exist: no such file or directory",
+			"my group: other: connection refused",
+			"EOF",
+		})
+
+		assert.ErrorIs(t, deduped, errConnRefused)
+		assert.ErrorIs(t, deduped, io.EOF)
+
+		var target *os.PathError
+		assert.ErrorAs(t, deduped, &target)
+
+		var counter interface{ Count() int }
+		require.ErrorAs(t, deduped, &counter)
+		assert.Equal(t, 3, counter.Count())
+	})
+
+	t.Run("Path", func(t *testing.T) {
+		t.Parallel()
+
+		path := grouperror.Path{grouperror.Field("name")}
+		err := grouperror.Join(
+			grouperror.PrefixPath(path, io.EOF),
+			grouperror.PrefixPath(path, io.EOF),
+		)
+
+		leaves := grouperror.Leaves(grouperror.Dedup(err))
+		require.Len(t, leaves, 1)
+		assert.Equal(t, path, leaves[0].Path)
+		assert.Equal(t, "name: ", leaves[0].Prefix)
+		assert.EqualError(t, leaves[0].Err, "EOF (x2)")
+	})
+}
+
+//nolint:goerr113
+func TestDedupFunc(t *testing.T) {
+	t.Parallel()
+
+	err := grouperror.Join(
+		grouperror.Prefix("user #1: ", errors.New("connection refused")),
+		grouperror.Prefix("user #2: ", errors.New("connection refused")),
+		grouperror.Prefix("user #3: ", io.EOF),
+	)
+
+	deduped := grouperror.DedupFunc(err, func(l grouperror.Leaf) string {
+		return l.Err.Error()
+	})
+
+	assertMessages(t, deduped, []string{
+		"user #1: connection refused (x2)",
+		"user #3: EOF",
+	})
+
+	deduped = grouperror.DedupFunc(err, func(l grouperror.Leaf) string {
+		return strings.Split(l.Prefix, " ")[0]
+	})
+
+	assertMessages(t, deduped, []string{
+		"user #1: connection refused (x3)",
+	})
+}
@@ -26,6 +26,8 @@ package grouperror_test
 import (
 	"errors"
 	"fmt"
+
+	"github.com/gontainer/grouperror"
 )
 
 //nolint:goerr113
@@ -57,3 +59,22 @@ func ExamplePrefix_stdlib() {
 	// validation: invalid name
 	// invalid age
 }
+
+//nolint:goerr113
+func ExampleCollection_stdlib() {
+	err := errors.Join(
+		errors.New("invalid name"),
+		errors.New("invalid age"),
+	)
+
+	err = grouperror.Prefix("validation: ", err, errors.New("unexpected error"))
+
+	for _, x := range grouperror.Collection(err) {
+		fmt.Println(x)
+	}
+
+	// Output:
+	// validation: invalid name
+	// validation: invalid age
+	// validation: unexpected error
+}
@@ -23,6 +23,8 @@ package grouperror_test
 import (
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/gontainer/grouperror"
 )
@@ -72,3 +74,135 @@ func ExamplePrefix() {
 	// 2. operation failed: could not create new user: validation: invalid name
 	// 3. operation failed: could not create new user: validation: invalid age
 }
+
+func ExampleCollector() {
+	var c grouperror.Collector
+
+	c.Add(errors.New("invalid name")) //nolint:goerr113
+	c.Add(nil)                        // nil-errors are being ignored
+	c.Addf("invalid age: %d", -5)
+
+	fmt.Println(c.Len())
+	fmt.Println(c.Err())
+
+	// Output:
+	// 2
+	// invalid name
+	// invalid age: -5
+}
+
+func ExampleGroup() {
+	var g grouperror.Group
+	g.SetLimit(2)
+
+	for _, name := range []string{"Jane", "", "John", ""} {
+		name := name
+		g.Go(func() error {
+			if name == "" {
+				return errors.New("empty name") //nolint:goerr113
+			}
+
+			return nil
+		})
+	}
+
+	fmt.Println(grouperror.Prefix("validation: ", g.Wait()))
+
+	// Output:
+	// validation: empty name
+	// validation: empty name
+}
+
+func ExampleLeaves() {
+	err := grouperror.PrefixPath(
+		grouperror.Path{grouperror.Field("people"), grouperror.Index(2)},
+		grouperror.PrefixPath(grouperror.Path{grouperror.Field("name")}, errors.New("required")), //nolint:goerr113
+		grouperror.PrefixPath(grouperror.Path{grouperror.Field("age")}, errors.New("too low")),   //nolint:goerr113
+	)
+
+	fmt.Println(err)
+	fmt.Println()
+
+	for _, l := range grouperror.Leaves(err) {
+		fmt.Printf("%s: %s\n", l.Path, l.Err)
+	}
+
+	// Output:
+	// people[2]: name: required
+	// people[2]: age: too low
+	//
+	// people[2].name: required
+	// people[2].age: too low
+}
+
+func ExampleMarshalJSON() {
+	err := grouperror.Prefix(
+		"validation: ",
+		errors.New("invalid name"), //nolint:goerr113
+		grouperror.Prefix("address: ", errors.New("invalid zip code")), //nolint:goerr113
+	)
+
+	b, _ := grouperror.MarshalJSON(err)
+	fmt.Println(string(b))
+
+	b, _ = grouperror.MarshalJSONFlat(err)
+	fmt.Println(string(b))
+
+	// Output:
+	// {"prefix":"validation: ","errors":[{"message":"invalid name","type":"*errors.errorString"},{"prefix":"address: ","errors":[{"message":"invalid zip code","type":"*errors.errorString"}]}]}
+	// ["validation: invalid name","validation: address: invalid zip code"]
+}
+
+//nolint:goerr113
+func ExamplePrefix_tree() {
+	err := grouperror.Prefix(
+		"could not create new user: ",
+		errors.New("unexpected error"),
+		grouperror.Prefix("validation: ", errors.New("invalid name"), errors.New("invalid age")),
+	)
+
+	fmt.Printf("%+v\n", err)
+
+	// Output:
+	// group "could not create new user: "
+	// ├── unexpected error
+	// └── group "validation: "
+	//     ├── invalid name
+	//     └── invalid age
+}
+
+func ExampleDedup() {
+	errConnRefused := errors.New("connection refused") //nolint:goerr113
+
+	err := grouperror.Prefix("my group: ", errConnRefused, errConnRefused, io.EOF, errConnRefused)
+	fmt.Println(grouperror.Dedup(err))
+
+	// Output:
+	// my group: connection refused (x3)
+	// my group: EOF
+}
+
+//nolint:goerr113
+func ExampleWalk() {
+	err := grouperror.Prefix(
+		"validation: ",
+		errors.New("invalid name"),
+		grouperror.Prefix("address: ", errors.New("invalid zip code")),
+	)
+
+	_ = grouperror.Walk(err, func(n grouperror.Node) error {
+		if n.Group {
+			fmt.Printf("%sgroup %q\n", strings.Repeat("  ", n.Depth), n.Prefix)
+		} else {
+			fmt.Printf("%s%s\n", strings.Repeat("  ", n.Depth), n.Err)
+		}
+
+		return nil
+	})
+
+	// Output:
+	// group "validation: "
+	//   invalid name
+	//   group "address: "
+	//     invalid zip code
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"errors"
+)
+
+/*
+FieldError is a validation error of a single field. It carries a machine-readable code and parameters,
+and composes with [Join], [Prefix] and [PrefixPath].
+
+	err := grouperror.PrefixPath(
+	    grouperror.Path{grouperror.Field("user")},
+	    &grouperror.FieldError{
+	        Path:    grouperror.Path{grouperror.Field("name")},
+	        Code:    "too_long",
+	        Message: "must be at most 64 characters long",
+	        Params:  map[string]any{"max": 64},
+	    },
+	)
+	fmt.Println(err)
+	// Output:
+	// user: name: must be at most 64 characters long
+
+See [FieldErrors].
+*/
+type FieldError struct {
+	// Path is the path of the field relative to the enclosing groups.
+	Path Path
+	// Code is a machine-readable code, e.g. "required".
+	Code string
+	// Message is a human-readable message.
+	Message string
+	// Params are the parameters of the error, e.g. {"max": 64}.
+	Params map[string]any
+	// Prefix is the accumulated prefix of the enclosing groups, it is set by [FieldErrors].
+	Prefix string
+}
+
+// Error returns the path and the message, or the code if the message is empty.
+func (e *FieldError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = e.Code
+	}
+
+	if len(e.Path) == 0 {
+		return msg
+	}
+
+	return e.Path.String() + ": " + msg
+}
+
+/*
+FieldErrors extracts all errors of the type [*FieldError] from the given group.
+The returned values are copies, their paths are resolved, they include the paths of all the enclosing groups,
+and [FieldError.Prefix] contains the accumulated prefix of the enclosing groups.
+
+	err := grouperror.PrefixPath(
+	    grouperror.Path{grouperror.Field("people"), grouperror.Index(2)},
+	    &grouperror.FieldError{Path: grouperror.Path{grouperror.Field("name")}, Code: "required"},
+	)
+	for _, fe := range grouperror.FieldErrors(err) {
+	    fmt.Println(fe.Path, fe.Code)
+	}
+	// Output:
+	// people[2].name required
+*/
+func FieldErrors(err error) []FieldError {
+	var result []FieldError
+
+	eachLeaf(err, "", nil, func(l Leaf) bool {
+		var fe *FieldError
+		if errors.As(l.Err, &fe) {
+			c := *fe
+			c.Path = l.Path.join(fe.Path)
+			c.Prefix = l.Prefix
+			result = append(result, c)
+		}
+
+		return true
+	})
+
+	return result
+}
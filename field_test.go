@@ -0,0 +1,142 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldError_Error(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		err      *grouperror.FieldError
+		expected string
+	}{
+		{
+			err:      &grouperror.FieldError{Code: "required"},
+			expected: "required",
+		},
+		{
+			err:      &grouperror.FieldError{Code: "required", Message: "is required"},
+			expected: "is required",
+		},
+		{
+			err: &grouperror.FieldError{
+				Path:    grouperror.Path{grouperror.Field("people"), grouperror.Index(0), grouperror.Field("name")},
+				Code:    "required",
+				Message: "is required",
+			},
+			expected: "people[0].name: is required",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+
+		t.Run(s.expected, func(t *testing.T) {
+			t.Parallel()
+
+			assert.EqualError(t, s.err, s.expected)
+		})
+	}
+}
+
+func TestFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, grouperror.FieldErrors(nil))
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		name := &grouperror.FieldError{
+			Path:    grouperror.Path{grouperror.Field("name")},
+			Code:    "too_long",
+			Message: "must be at most 64 characters long",
+			Params:  map[string]interface{}{"max": 64},
+		}
+		age := &grouperror.FieldError{
+			Code: "required",
+		}
+
+		err := grouperror.Prefix(
+			"validation: ",
+			grouperror.PrefixPath(
+				grouperror.Path{grouperror.Field("people"), grouperror.Index(2)},
+				name,
+				io.EOF,
+				grouperror.PrefixPath(grouperror.Path{grouperror.Field("age")}, fmt.Errorf("invalid: %w", age)),
+			),
+			age,
+		)
+
+		expected := []grouperror.FieldError{
+			{
+				Path: grouperror.Path{
+					grouperror.Field("people"),
+					grouperror.Index(2),
+					grouperror.Field("name"),
+				},
+				Code:    "too_long",
+				Message: "must be at most 64 characters long",
+				Params:  map[string]interface{}{"max": 64},
+				Prefix:  "validation: people[2]: ",
+			},
+			{
+				Path: grouperror.Path{
+					grouperror.Field("people"),
+					grouperror.Index(2),
+					grouperror.Field("age"),
+				},
+				Code:   "required",
+				Prefix: "validation: people[2]: age: ",
+			},
+			{
+				Code:   "required",
+				Prefix: "validation: ",
+			},
+		}
+
+		assert.Equal(t, expected, grouperror.FieldErrors(err))
+		assert.EqualError(
+			t,
+			err,
+			"validation: people[2]: name: must be at most 64 characters long\n"+
+				"validation: people[2]: EOF\n"+
+				"validation: people[2]: age: invalid: required\n"+
+				"validation: required",
+		)
+
+		// the original errors remain unchanged
+		assert.Equal(t, grouperror.Path{grouperror.Field("name")}, name.Path)
+		assert.Empty(t, name.Prefix)
+	})
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+/*
+Format implements [fmt.Formatter].
+
+	%s, %v  the same as Error()
+	%q      a double-quoted result of Error()
+	%+v     a tree of the nested groups, including call stacks, see [PrefixWithStack]
+	%#v     a Go-syntax representation of the group
+
+Sample output of %+v:
+
+	group "operation failed: "
+	└── group "could not create new user: "
+	    ├── unexpected error
+	    └── group "validation: "
+	        ├── invalid name
+	        └── invalid age
+*/
+func (g *groupError) Format(s fmt.State, verb rune) {
+	format(s, verb, g, g.tree, g.goString)
+}
+
+func format(s fmt.State, verb rune, err error, tree func() string, goString func() string) {
+	switch verb {
+	case 'v':
+		switch {
+		case s.Flag('+'):
+			_, _ = io.WriteString(s, tree())
+		case s.Flag('#'):
+			_, _ = io.WriteString(s, goString())
+		default:
+			_, _ = io.WriteString(s, err.Error())
+		}
+	case 's':
+		_, _ = io.WriteString(s, err.Error())
+	case 'q':
+		_, _ = fmt.Fprintf(s, "%q", err.Error())
+	default:
+		_, _ = fmt.Fprintf(s, "%%!%c(%T=%s)", verb, err, err.Error())
+	}
+}
+
+func (g *groupError) tree() string {
+	lines := []string{fmt.Sprintf("group %q", g.prefix)}
+	lines = appendStack(lines, g.stack, "")
+	lines = appendTree(lines, g.errors, "")
+
+	return strings.Join(lines, "\n")
+}
+
+func appendTree(lines []string, errs []error, indent string) []string {
+	for i, err := range errs {
+		branch, next := "├── ", "│   "
+		if i == len(errs)-1 {
+			branch, next = "└── ", "    "
+		}
+
+		// limits are rendered by [limitError.Format]
+		if g, ok := groupOf(err); ok && !isLimit(err) {
+			lines = append(lines, indent+branch+fmt.Sprintf("group %q", g.prefix))
+			lines = appendStack(lines, g.stack, indent+next)
+			lines = appendTree(lines, g.errors, indent+next)
+
+			continue
+		}
+
+		for j, l := range strings.Split(fmt.Sprintf("%+v", err), "\n") {
+			if j == 0 {
+				lines = append(lines, indent+branch+l)
+			} else {
+				lines = append(lines, indent+next+l)
+			}
+		}
+	}
+
+	return lines
+}
+
+func appendStack(lines []string, s stack, indent string) []string {
+	for _, l := range s.lines() {
+		lines = append(lines, indent+"│   "+l)
+	}
+
+	return lines
+}
+
+func (g *groupError) goString() string {
+	var b strings.Builder
+
+	_, _ = fmt.Fprintf(&b, "grouperror.Prefix(%q", g.prefix)
+
+	for _, err := range g.errors {
+		_, _ = fmt.Fprintf(&b, ", %#v", err)
+	}
+
+	b.WriteString(")")
+
+	return b.String()
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:goerr113
+func TestGroupError_Format(t *testing.T) {
+	t.Parallel()
+
+	multiLine := errors.New("multi-line\nerror")
+	err := grouperror.Prefix(
+		"operation failed: ",
+		grouperror.Prefix(
+			"could not create new user: ",
+			errors.New("unexpected error"),
+			grouperror.Prefix(
+				"validation: ",
+				errors.New("invalid name"),
+				errors.New("invalid age"),
+			),
+		),
+		&wrappedError{error: multiLine},
+		io.EOF,
+	)
+
+	scenarios := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format: "%v",
+			expected: "operation failed: could not create new user: unexpected error\n" +
+				"operation failed: could not create new user: validation: invalid name\n" +
+				"operation failed: could not create new user: validation: invalid age\n" +
+				"operation failed: multi-line\n" +
+				"error\n" +
+				"operation failed: EOF",
+		},
+		{
+			format: "%s",
+			expected: "operation failed: could not create new user: unexpected error\n" +
+				"operation failed: could not create new user: validation: invalid name\n" +
+				"operation failed: could not create new user: validation: invalid age\n" +
+				"operation failed: multi-line\n" +
+				"error\n" +
+				"operation failed: EOF",
+		},
+		{
+			format: "%+v",
+			expected: `group "operation failed: "
+├── group "could not create new user: "
+│   ├── unexpected error
+│   └── group "validation: "
+│       ├── invalid name
+│       └── invalid age
+├── group ""
+│   └── multi-line
+│       error
+└── EOF`,
+		},
+		{
+			format: "%#v",
+			expected: `grouperror.Prefix("operation failed: ", ` +
+				`grouperror.Prefix("could not create new user: ", ` +
+				`&errors.errorString{s:"unexpected error"}, ` +
+				`grouperror.Prefix("validation: ", &errors.errorString{s:"invalid name"}, &errors.errorString{s:"invalid age"})), ` +
+				`&grouperror_test.wrappedError{error:(*errors.errorString)(` + fmt.Sprintf("%p", multiLine) + `)}, ` +
+				`&errors.errorString{s:"EOF"})`,
+		},
+		{
+			format:   "%q",
+			expected: fmt.Sprintf("%q", err.Error()),
+		},
+		{
+			format:   "%d",
+			expected: "%!d(*grouperror.groupError=" + err.Error() + ")",
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+
+		t.Run(s.format, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, s.expected, fmt.Sprintf(s.format, err))
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"fmt"
+	"sync"
+)
+
+/*
+Group runs tasks in separate goroutines and collects all their errors.
+Contrary to [golang.org/x/sync/errgroup], it does not stop on the first error.
+The zero value is ready to use and does not limit the number of active goroutines.
+
+	var g grouperror.Group
+	g.SetLimit(2)
+	for _, url := range urls {
+	    url := url
+	    g.Go(func() error {
+	        return fetch(url)
+	    })
+	}
+	err := g.Wait()
+
+The errors returned by [Group.Wait] are ordered by the order the tasks were submitted,
+regardless of the order they have finished.
+*/
+type Group struct {
+	wg     sync.WaitGroup
+	sem    chan struct{}
+	mu     sync.Mutex
+	errors []error
+}
+
+// SetLimit limits the number of active goroutines in the group to at most n.
+// A negative value indicates no limit.
+//
+// It must not be modified while any goroutines in the group are active.
+func (g *Group) SetLimit(n int) {
+	if len(g.sem) != 0 {
+		panic(fmt.Sprintf("grouperror: modify limit while %d goroutines in the group are still active", len(g.sem)))
+	}
+
+	if n < 0 {
+		g.sem = nil
+
+		return
+	}
+
+	g.sem = make(chan struct{}, n)
+}
+
+// Go calls the given function in a new goroutine.
+// It blocks until the new goroutine can be added without the number of active goroutines
+// in the group exceeding the configured limit.
+func (g *Group) Go(f func() error) {
+	g.mu.Lock()
+	i := len(g.errors)
+	g.errors = append(g.errors, nil)
+	g.mu.Unlock()
+
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+
+	g.wg.Add(1)
+
+	go func() {
+		defer g.done()
+
+		err := f()
+
+		g.mu.Lock()
+		g.errors[i] = err
+		g.mu.Unlock()
+	}()
+}
+
+func (g *Group) done() {
+	if g.sem != nil {
+		<-g.sem
+	}
+
+	g.wg.Done()
+}
+
+// Wait blocks until all function calls from the [Group.Go] method have returned,
+// then joins all the returned errors the same way as [Join].
+// It returns nil, when all the tasks have succeeded.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return Join(g.errors...)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:goerr113
+func TestGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("No tasks", func(t *testing.T) {
+		t.Parallel()
+
+		var g grouperror.Group
+		require.NoError(t, g.Wait())
+	})
+
+	t.Run("No errors", func(t *testing.T) {
+		t.Parallel()
+
+		var g grouperror.Group
+		for i := 0; i < 10; i++ {
+			g.Go(func() error {
+				return nil
+			})
+		}
+
+		require.NoError(t, g.Wait())
+	})
+
+	t.Run("Order of submission", func(t *testing.T) {
+		t.Parallel()
+
+		const max = 5
+
+		var g grouperror.Group
+		for i := 0; i < max; i++ {
+			i := i
+			g.Go(func() error {
+				// the first task finishes as the last one
+				time.Sleep(time.Duration(max-i) * 5 * time.Millisecond)
+
+				if i%2 == 1 {
+					return nil
+				}
+
+				return fmt.Errorf("task #%d", i)
+			})
+		}
+
+		err := g.Wait()
+		expected := []string{
+			"task #0",
+			"task #2",
+			"task #4",
+		}
+
+		errs := grouperror.Collection(err)
+		require.Len(t, errs, len(expected))
+		for i, x := range errs {
+			assert.EqualError(t, x, expected[i]) //nolint:testifylint
+		}
+	})
+
+	t.Run("SetLimit", func(t *testing.T) {
+		t.Parallel()
+
+		const (
+			limit = 3
+			max   = 20
+		)
+
+		var (
+			g       grouperror.Group
+			active  int32
+			maxSeen int32
+		)
+
+		g.SetLimit(limit)
+
+		for i := 0; i < max; i++ {
+			i := i
+			g.Go(func() error {
+				n := atomic.AddInt32(&active, 1)
+				defer atomic.AddInt32(&active, -1)
+
+				for {
+					m := atomic.LoadInt32(&maxSeen)
+					if n <= m || atomic.CompareAndSwapInt32(&maxSeen, m, n) {
+						break
+					}
+				}
+
+				time.Sleep(time.Millisecond)
+
+				return fmt.Errorf("task #%d", i)
+			})
+		}
+
+		err := g.Wait()
+		assert.Len(t, grouperror.Collection(err), max)
+		assert.LessOrEqual(t, atomic.LoadInt32(&maxSeen), int32(limit))
+	})
+
+	t.Run("SetLimit while active", func(t *testing.T) {
+		t.Parallel()
+
+		var g grouperror.Group
+		g.SetLimit(1)
+
+		release := make(chan struct{})
+		g.Go(func() error {
+			<-release
+
+			return nil
+		})
+
+		assert.PanicsWithValue(
+			t,
+			"grouperror: modify limit while 1 goroutines in the group are still active",
+			func() {
+				g.SetLimit(2)
+			},
+		)
+
+		close(release)
+		require.NoError(t, g.Wait())
+	})
+}
@@ -22,8 +22,7 @@ package grouperror
 
 import (
 	"errors"
-	"fmt"
-	"strings"
+	"sync"
 )
 
 // Join joins provided errors. It ignores nil-values.
@@ -34,6 +33,20 @@ func Join(errs ...error) error {
 
 // Prefix joins errors the same way as [Join], and adds a prefix to the group.
 func Prefix(prefix string, errs ...error) error {
+	filtered := filterNil(errs)
+	if filtered == nil {
+		return nil
+	}
+
+	return &groupError{
+		prefix: prefix,
+		errors: filtered,
+	}
+}
+
+// filterNil returns non-nil errors from the given slice.
+// It returns nil, when there are no such errors.
+func filterNil(errs []error) []error {
 	n := 0
 
 	for _, err := range errs {
@@ -54,48 +67,64 @@ func Prefix(prefix string, errs ...error) error {
 		}
 	}
 
-	return &groupError{
-		prefix: prefix,
-		errors: filtered,
-	}
+	return filtered
 }
 
 type groupError struct {
 	prefix string
+	path   Path
 	errors []error
+	stack  stack
+
+	once sync.Once
+	msg  string
 }
 
+// Error renders the errors returned by [Collection], one per line. The result is computed once.
 func (g *groupError) Error() string {
-	c := g.Collection()
-	s := make([]string, 0, len(c))
-
-	for _, err := range c {
-		s = append(s, err.Error())
-	}
+	g.once.Do(func() {
+		g.msg = render(g)
+	})
 
-	return strings.Join(s, "\n")
+	return g.msg
 }
 
+// Unwrap returns the original errors of the group, prefixes are applied only while rendering.
+// The returned slice must not be modified.
 func (g *groupError) Unwrap() []error {
-	return g.Collection()
+	return g.errors
 }
 
+// Collection returns the flattened list of errors with prefixes, see [Collection].
 func (g *groupError) Collection() []error {
-	errs := make([]error, 0, len(g.errors))
-
-	for _, err := range g.errors {
-		if group, ok := err.(interface{ Collection() []error }); ok { //nolint:errorlint
-			for _, x := range group.Collection() {
-				errs = append(errs, fmt.Errorf("%s%w", g.prefix, x))
-			}
+	return Collection(g)
+}
 
-			continue
-		}
+// prefixed adds the given prefix to the message of the given error.
+// It returns the given error, when the prefix is empty.
+func prefixed(prefix string, err error) error {
+	if prefix == "" {
+		return err
+	}
 
-		errs = append(errs, fmt.Errorf("%s%w", g.prefix, err))
+	return &prefixedError{
+		prefix: prefix,
+		err:    err,
 	}
+}
 
-	return errs
+// prefixedError adds the accumulated prefix of all the enclosing groups to a single error.
+type prefixedError struct {
+	prefix string
+	err    error
+}
+
+func (p *prefixedError) Error() string {
+	return p.prefix + p.err.Error()
+}
+
+func (p *prefixedError) Unwrap() error {
+	return p.err
 }
 
 // Is provides support for [errors.Is] in older versions of Go (<1.20)
@@ -114,7 +143,7 @@ func (g *groupError) Is(target error) bool {
 // As provides support for [errors.As] in older versions of Go (<1.20)
 //
 // https://tip.golang.org/doc/go1.20#errors
-func (g *groupError) As(target any) bool {
+func (g *groupError) As(target interface{}) bool {
 	for _, err := range g.errors {
 		if errors.As(err, target) {
 			return true
@@ -125,8 +154,12 @@ func (g *groupError) As(target any) bool {
 }
 
 /*
-Collection extracts an error collection from the given error if it has a `Collection() []error` method.
+Collection extracts an error collection from the given error if it has a `Collection() []error`,
+or an `Unwrap() []error` method, e.g. errors created by [errors.Join] in Go 1.20+.
 It works recursively.
+Errors with an `Unwrap() []error` method are treated as collections only when their messages are
+the messages of the wrapped errors joined with "\n", as for [errors.Join].
+Other errors, e.g. created by [fmt.Errorf] with multiple %w verbs, are single errors, so their messages are preserved.
 
 	err := grouperror.Prefix("my group: ", errors.New("error1"), nil, errors.New("error2"))
 	for _, x := range grouperror.Collection(err) {
@@ -144,16 +177,17 @@ func Collection(err error) []error {
 		return nil
 	}
 
-	if group, ok := err.(interface{ Collection() []error }); ok { //nolint:errorlint
-		collection := group.Collection()
-		errs := make([]error, 0, len(collection))
+	if _, ok := groupOf(err); !ok {
+		return []error{err}
+	}
+
+	var errs []error
 
-		for _, x := range collection {
-			errs = append(errs, Collection(x)...)
-		}
+	eachLeaf(err, "", nil, func(l Leaf) bool {
+		errs = append(errs, prefixed(l.Prefix, l.Err))
 
-		return errs
-	}
+		return true
+	})
 
-	return []error{err}
+	return errs
 }
@@ -0,0 +1,164 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+)
+
+// newBenchmarkGroup returns a group of the given depth, each group contains width-1 errors and one subgroup,
+// the deepest group contains width errors.
+//
+//nolint:goerr113
+func newBenchmarkGroup(depth int, width int) error {
+	errs := make([]error, 0, width)
+
+	for i := 0; i < width-1; i++ {
+		errs = append(errs, fmt.Errorf("error #%d", i))
+	}
+
+	if depth > 1 {
+		errs = append(errs, newBenchmarkGroup(depth-1, width))
+	} else {
+		errs = append(errs, io.EOF)
+	}
+
+	return grouperror.Prefix(fmt.Sprintf("depth %d: ", depth), errs...)
+}
+
+var benchmarkSizes = []struct {
+	depth int
+	width int
+}{
+	{depth: 1, width: 10},
+	{depth: 5, width: 10},
+	{depth: 5, width: 200},
+	{depth: 5, width: 1000},
+	{depth: 20, width: 10},
+}
+
+func BenchmarkGroupError_Unwrap(b *testing.B) {
+	for _, s := range benchmarkSizes {
+		err := newBenchmarkGroup(s.depth, s.width)
+
+		b.Run(fmt.Sprintf("depth=%d,width=%d", s.depth, s.width), func(b *testing.B) {
+			var group interface{ Unwrap() []error }
+			if !errors.As(err, &group) {
+				b.Fatal("unexpected error")
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_ = group.Unwrap()
+			}
+		})
+	}
+}
+
+func BenchmarkGroupError_Collection(b *testing.B) {
+	for _, s := range benchmarkSizes {
+		err := newBenchmarkGroup(s.depth, s.width)
+
+		b.Run(fmt.Sprintf("depth=%d,width=%d", s.depth, s.width), func(b *testing.B) {
+			var group interface{ Collection() []error }
+			if !errors.As(err, &group) {
+				b.Fatal("unexpected error")
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_ = group.Collection()
+			}
+		})
+	}
+}
+
+func BenchmarkGroupError_Error(b *testing.B) {
+	for _, s := range benchmarkSizes {
+		err := newBenchmarkGroup(s.depth, s.width)
+
+		b.Run(fmt.Sprintf("depth=%d,width=%d", s.depth, s.width), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_ = err.Error()
+			}
+		})
+	}
+}
+
+func BenchmarkGroupError_Error_uncached(b *testing.B) {
+	for _, s := range benchmarkSizes {
+		err := newBenchmarkGroup(s.depth, s.width)
+
+		b.Run(fmt.Sprintf("depth=%d,width=%d", s.depth, s.width), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				// a new group is not cached yet
+				_ = grouperror.Join(err).Error()
+			}
+		})
+	}
+}
+
+func BenchmarkCollection(b *testing.B) {
+	for _, s := range benchmarkSizes {
+		err := newBenchmarkGroup(s.depth, s.width)
+
+		b.Run(fmt.Sprintf("depth=%d,width=%d", s.depth, s.width), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_ = grouperror.Collection(err)
+			}
+		})
+	}
+}
+
+func BenchmarkErrorsIs(b *testing.B) {
+	for _, s := range benchmarkSizes {
+		err := newBenchmarkGroup(s.depth, s.width)
+
+		b.Run(fmt.Sprintf("depth=%d,width=%d", s.depth, s.width), func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if !errors.Is(err, io.EOF) {
+					b.Fatal("unexpected result")
+				}
+			}
+		})
+	}
+}
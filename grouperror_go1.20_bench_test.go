@@ -0,0 +1,88 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.20
+// +build go1.20
+
+package grouperror_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+)
+
+// newBenchmarkJoin returns nested errors of the given depth created by the given join function,
+// each level contains width-1 errors and one nested level.
+//
+//nolint:goerr113
+func newBenchmarkJoin(depth int, width int, join func(...error) error) error {
+	errs := make([]error, 0, width)
+
+	for i := 0; i < width-1; i++ {
+		errs = append(errs, fmt.Errorf("error #%d", i))
+	}
+
+	if depth > 1 {
+		errs = append(errs, newBenchmarkJoin(depth-1, width, join))
+	} else {
+		errs = append(errs, io.EOF)
+	}
+
+	return join(errs...)
+}
+
+// BenchmarkCollection_join measures the cost of recognizing nested errors created by [errors.Join],
+// compared to custom types that must be recognized by their messages.
+func BenchmarkCollection_join(b *testing.B) {
+	joins := []struct {
+		name string
+		join func(...error) error
+	}{
+		{
+			name: "errors.Join",
+			join: errors.Join,
+		},
+		{
+			name: "custom",
+			join: func(errs ...error) error {
+				return multiError(errs)
+			},
+		},
+	}
+
+	for _, j := range joins {
+		for _, s := range benchmarkSizes {
+			err := grouperror.Prefix("prefix: ", newBenchmarkJoin(s.depth, s.width, j.join))
+
+			b.Run(fmt.Sprintf("%s,depth=%d,width=%d", j.name, s.depth, s.width), func(b *testing.B) {
+				b.ReportAllocs()
+				b.ResetTimer()
+
+				for i := 0; i < b.N; i++ {
+					_ = grouperror.Collection(err)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.20
+// +build go1.20
+
+package grouperror_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollection_stdlib(t *testing.T) {
+	t.Parallel()
+
+	t.Run("errors.Join", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Prefix("p: ", errors.Join(io.EOF, nil, io.ErrUnexpectedEOF))
+		assert.Equal(t, "p: EOF\np: unexpected EOF", err.Error())
+
+		collection := grouperror.Collection(err)
+		require.Len(t, collection, 2)
+		assert.EqualError(t, collection[0], "p: EOF")
+		assert.EqualError(t, collection[1], "p: unexpected EOF")
+	})
+
+	t.Run("fmt.Errorf with multiple %w", func(t *testing.T) {
+		t.Parallel()
+
+		wrapped := fmt.Errorf("read %w then %w", io.EOF, io.ErrUnexpectedEOF)
+		err := grouperror.Prefix("p: ", wrapped)
+		assert.Equal(t, "p: read EOF then unexpected EOF", err.Error())
+
+		leaves := grouperror.Leaves(err)
+		require.Len(t, leaves, 1)
+		assert.Equal(t, "p: ", leaves[0].Prefix)
+		assert.Same(t, wrapped, leaves[0].Err)
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
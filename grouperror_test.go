@@ -27,6 +27,7 @@ import (
 	"net"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/gontainer/grouperror"
@@ -87,6 +88,61 @@ func TestPrefixedGroup(t *testing.T) {
 	})
 }
 
+//nolint:goerr113
+func TestPrefixedGroup_wrappers(t *testing.T) {
+	t.Parallel()
+
+	errName := errors.New("invalid name")
+
+	err := grouperror.Prefix(
+		"Validation: ",
+		grouperror.Prefix("Person: ", grouperror.Prefix("Name: ", errName)),
+		io.EOF,
+	)
+
+	t.Run("Collection", func(t *testing.T) {
+		t.Parallel()
+
+		errs := grouperror.Collection(err)
+		require.Len(t, errs, 2)
+
+		// a single wrapper per error, regardless of the depth
+		assert.Same(t, errName, errors.Unwrap(errs[0]))
+		assert.Same(t, io.EOF, errors.Unwrap(errs[1]))
+	})
+
+	t.Run("Empty prefix", func(t *testing.T) {
+		t.Parallel()
+
+		errs := grouperror.Collection(grouperror.Join(grouperror.Join(io.EOF), errName))
+		assert.Equal(t, []error{io.EOF, errName}, errs)
+	})
+
+	t.Run("Empty messages", func(t *testing.T) {
+		t.Parallel()
+
+		assert.EqualError(t, grouperror.Join(errors.New(""), errors.New("")), "\n")
+	})
+
+	t.Run("Concurrent Error()", func(t *testing.T) {
+		t.Parallel()
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				assert.Equal(t, "Validation: Person: Name: invalid name\nValidation: EOF", err.Error())
+			}()
+		}
+
+		wg.Wait()
+	})
+}
+
 type wrappedError struct {
 	error
 }
@@ -95,6 +151,36 @@ func (w *wrappedError) Collection() []error {
 	return []error{w.error}
 }
 
+// multiError mimics errors created by [errors.Join].
+type multiError []error
+
+func (m multiError) Error() string {
+	msgs := make([]string, 0, len(m))
+
+	for _, err := range m {
+		if err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+func (m multiError) Unwrap() []error {
+	return m
+}
+
+// countError wraps multiple errors, but it has its own message.
+type countError []error
+
+func (c countError) Error() string {
+	return fmt.Sprintf("%d errors", len(c))
+}
+
+func (c countError) Unwrap() []error {
+	return c
+}
+
 func TestCollection(t *testing.T) {
 	t.Parallel()
 
@@ -146,6 +232,83 @@ func TestCollection(t *testing.T) {
 	})
 }
 
+//nolint:goerr113
+func TestCollection_unwrapSlice(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Implements interface{ Unwrap() []error }", func(t *testing.T) {
+		t.Parallel()
+
+		err := multiError{
+			errors.New("error #1"),
+			nil,
+			multiError{errors.New("error #2"), errors.New("error #3")},
+		}
+
+		expected := []string{
+			"error #1",
+			"error #2",
+			"error #3",
+		}
+
+		collection := grouperror.Collection(err)
+		require.Len(t, collection, len(expected))
+
+		for i, x := range collection {
+			require.EqualError(t, x, expected[i])
+		}
+	})
+
+	t.Run("Own message", func(t *testing.T) {
+		t.Parallel()
+
+		inner := countError{errors.New("error #1"), errors.New("error #2")}
+		err := grouperror.Prefix("my group: ", inner, errors.New("error #3"))
+
+		collection := grouperror.Collection(err)
+		require.Len(t, collection, 2)
+		require.EqualError(t, collection[0], "my group: 2 errors")
+		require.EqualError(t, collection[1], "my group: error #3")
+		assert.ErrorIs(t, collection[0], inner[1])
+		assert.Equal(t, "my group: 2 errors\nmy group: error #3", err.Error())
+	})
+
+	t.Run("Nested in a group", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Prefix(
+			"my group: ",
+			multiError{
+				errors.New("multi-line\nerror #1"),
+				grouperror.Prefix("nested: ", errors.New("error #2")),
+			},
+			errors.New("error #3"),
+		)
+
+		expected := []string{
+			"my group: multi-line\nerror #1",
+			"my group: nested: error #2",
+			"my group: error #3",
+		}
+
+		collection := grouperror.Collection(err)
+		require.Len(t, collection, len(expected))
+
+		for i, x := range collection {
+			require.EqualError(t, x, expected[i])
+		}
+
+		leaves := grouperror.Leaves(err)
+		require.Len(t, leaves, len(expected))
+
+		for i, l := range leaves {
+			assert.Equal(t, expected[i], l.Prefix+l.Err.Error())
+		}
+
+		assert.Equal(t, strings.Join(expected, "\n"), err.Error())
+	})
+}
+
 func Test_groupError_Unwrap(t *testing.T) {
 	t.Parallel()
 
@@ -166,6 +329,22 @@ func Test_groupError_Unwrap(t *testing.T) {
 
 	err = grouperror.Prefix("errors: ", err)
 
+	t.Run("Original errors", func(t *testing.T) {
+		t.Parallel()
+
+		var group interface{ Unwrap() []error }
+		require.ErrorAs(t, err, &group)
+
+		errs := group.Unwrap()
+		require.Len(t, errs, 1)
+
+		require.ErrorAs(t, errs[0], &group)
+		errs = group.Unwrap()
+		require.Len(t, errs, 3)
+		assert.Same(t, io.ErrUnexpectedEOF, errs[1])
+		assert.EqualError(t, errs[0], "some errors: EOF\nsome errors: multiple Read calls return no data or error")
+	})
+
 	t.Run("errors.Is", func(t *testing.T) {
 		t.Parallel()
 		for _, target := range []error{io.EOF, io.ErrNoProgress, io.ErrUnexpectedEOF} {
@@ -0,0 +1,42 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package grouperrortest provides tools to test error groups using only the standard library:
+//
+//	func TestMyCode(t *testing.T) {
+//		err := grouperror.Join(io.EOF, os.ErrClosed)
+//		grouperrortest.EqualErrorGroup(t, err, []string{"EOF", "file already closed"})
+//		grouperrortest.ErrorIs(t, err, []error{io.EOF, os.ErrClosed})
+//	}
+//
+// See [github.com/gontainer/grouperror/assert] for the equivalent based on https://github.com/stretchr/testify.
+//
+// This package and the package grouperror import only the standard library, so test binaries that use them
+// do not link testify. The module still requires testify, because it provides the packages assert and require.
+//
+// Golden files of [Snapshot] and [SnapshotTree] are rewritten when the environment variable
+// GROUPERRORTEST_UPDATE is set, instead of the flag -update:
+//
+//	GROUPERRORTEST_UPDATE=1 go test ./...
+//
+// This package does not define the flag -update, because defining flags in an imported package
+// makes "flag redefined" panics in test binaries that define their own -update flag.
+// Test binaries that define a boolean -update flag can use it as well: go test ./... -update
+package grouperrortest
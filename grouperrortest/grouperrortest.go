@@ -0,0 +1,173 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperrortest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+)
+
+const diffHeader = "error group does not match (-expected +actual):"
+
+func maxLen(a int, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func noError(tb testing.TB, name string, err error) bool {
+	tb.Helper()
+
+	if err == nil {
+		return true
+	}
+
+	tb.Errorf("%s: expected no error, got:\n\t%q", name, err.Error())
+
+	return false
+}
+
+// EqualErrorGroup checks that the given error is a group of errors with the following messages.
+// It checks the given error is equal to nil whenever `len(msgs) == 0`.
+//
+// See [grouperror.Collection].
+func EqualErrorGroup(tb testing.TB, err error, msgs []string) bool {
+	tb.Helper()
+
+	if len(msgs) == 0 {
+		return noError(tb, "EqualErrorGroup", err)
+	}
+
+	return matchEach(
+		tb,
+		"EqualErrorGroup",
+		err,
+		len(msgs),
+		func(i int, x error) bool {
+			return x.Error() == msgs[i]
+		},
+		func(i int) string {
+			return fmt.Sprintf("%q", msgs[i])
+		},
+	)
+}
+
+// ErrorIs checks that the given error is a group of errors,
+// and [errors.Is] reports true for each error and the corresponding target.
+// It checks the given error is equal to nil whenever `len(targets) == 0`.
+//
+// See [grouperror.Collection].
+func ErrorIs(tb testing.TB, err error, targets []error) bool {
+	tb.Helper()
+
+	if len(targets) == 0 {
+		return noError(tb, "ErrorIs", err)
+	}
+
+	return matchEach(
+		tb,
+		"ErrorIs",
+		err,
+		len(targets),
+		func(i int, x error) bool {
+			return errors.Is(x, targets[i])
+		},
+		func(i int) string {
+			return fmt.Sprintf("errors.Is(%q)", fmt.Sprint(targets[i]))
+		},
+	)
+}
+
+// ErrorAs checks that the given error is a group of errors,
+// and [errors.As] reports true for each error and the corresponding target.
+// It checks the given error is equal to nil whenever `len(targets) == 0`.
+//
+// See [grouperror.Collection].
+func ErrorAs(tb testing.TB, err error, targets []any) bool {
+	tb.Helper()
+
+	if len(targets) == 0 {
+		return noError(tb, "ErrorAs", err)
+	}
+
+	return matchEach(
+		tb,
+		"ErrorAs",
+		err,
+		len(targets),
+		func(i int, x error) bool {
+			return errors.As(x, targets[i])
+		},
+		func(i int) string {
+			if t := reflect.TypeOf(targets[i]); t != nil && t.Kind() == reflect.Ptr {
+				return fmt.Sprintf("errors.As(%s)", t.Elem())
+			}
+
+			return fmt.Sprintf("errors.As(%T)", targets[i])
+		},
+	)
+}
+
+func matchEach(
+	tb testing.TB,
+	name string,
+	err error,
+	n int,
+	match func(int, error) bool,
+	describe func(int) string,
+) bool {
+	tb.Helper()
+
+	errs := grouperror.Collection(err)
+	ok := len(errs) == n
+	lines := make([]string, 0, maxLen(len(errs), n))
+
+	for i := 0; i < maxLen(len(errs), n); i++ {
+		if i < n && i < len(errs) && match(i, errs[i]) {
+			lines = append(lines, fmt.Sprintf("  [%d] %q", i, errs[i].Error()))
+
+			continue
+		}
+
+		ok = false
+
+		if i < n {
+			lines = append(lines, fmt.Sprintf("- [%d] %s", i, describe(i)))
+		}
+
+		if i < len(errs) {
+			lines = append(lines, fmt.Sprintf("+ [%d] %q", i, errs[i].Error()))
+		}
+	}
+
+	if !ok {
+		tb.Errorf("%s: %s\n\t%s", name, diffHeader, strings.Join(lines, "\n\t"))
+	}
+
+	return ok
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperrortest_test
+
+import (
+	"fmt"
+	"go/build"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/gontainer/grouperror/grouperrortest"
+)
+
+type mockTB struct {
+	testing.TB
+	output string
+}
+
+func (m *mockTB) Helper() {}
+
+func (m *mockTB) Errorf(format string, args ...interface{}) {
+	m.output += fmt.Sprintf(format, args...)
+}
+
+type scenario struct {
+	name     string
+	check    func(tb testing.TB) bool
+	expected string
+}
+
+func run(t *testing.T, scenarios []scenario) {
+	t.Helper()
+
+	for _, s := range scenarios {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			mt := &mockTB{}
+			ok := s.check(mt)
+
+			if ok != (s.expected == "") {
+				t.Errorf("unexpected result: %v", ok)
+			}
+
+			if mt.output != s.expected {
+				t.Errorf("unexpected output:\n%s\nexpected:\n%s", mt.output, s.expected)
+			}
+		})
+	}
+}
+
+type customError struct{}
+
+func (*customError) Error() string {
+	return "custom error"
+}
+
+func pathError() error {
+	_, err := os.Open("file does not exist")
+
+	return err //nolint:wrapcheck
+}
+
+func TestEqualErrorGroup(t *testing.T) {
+	t.Parallel()
+
+	err := grouperror.Prefix("my group: ", io.EOF, os.ErrClosed)
+
+	run(t, []scenario{
+		{
+			name: "No errors [OK]",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.EqualErrorGroup(tb, nil, nil)
+			},
+		},
+		{
+			name: "No errors [error]",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.EqualErrorGroup(tb, io.EOF, nil)
+			},
+			expected: "EqualErrorGroup: expected no error, got:\n\t\"EOF\"",
+		},
+		{
+			name: "Equal errors [OK]",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.EqualErrorGroup(tb, err, []string{"my group: EOF", "my group: file already closed"})
+			},
+		},
+		{
+			name: "Equal errors [error]",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.EqualErrorGroup(tb, err, []string{"my group: EOF", "file already closed", "extra"})
+			},
+			expected: "EqualErrorGroup: error group does not match (-expected +actual):\n" +
+				"\t  [0] \"my group: EOF\"\n" +
+				"\t- [1] \"file already closed\"\n" +
+				"\t+ [1] \"my group: file already closed\"\n" +
+				"\t- [2] \"extra\"",
+		},
+		{
+			name: "Too many errors",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.EqualErrorGroup(tb, err, []string{"my group: EOF"})
+			},
+			expected: "EqualErrorGroup: error group does not match (-expected +actual):\n" +
+				"\t  [0] \"my group: EOF\"\n" +
+				"\t+ [1] \"my group: file already closed\"",
+		},
+	})
+}
+
+func TestErrorIs(t *testing.T) {
+	t.Parallel()
+
+	err := grouperror.Join(io.EOF, pathError())
+
+	run(t, []scenario{
+		{
+			name: "No errors [OK]",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.ErrorIs(tb, nil, nil)
+			},
+		},
+		{
+			name: "OK",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.ErrorIs(tb, err, []error{io.EOF, os.ErrNotExist})
+			},
+		},
+		{
+			name: "Error",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.ErrorIs(tb, err, []error{io.EOF, os.ErrClosed, io.ErrUnexpectedEOF})
+			},
+			expected: "ErrorIs: error group does not match (-expected +actual):\n" +
+				"\t  [0] \"EOF\"\n" +
+				"\t- [1] errors.Is(\"file already closed\")\n" +
+				"\t+ [1] \"open file does not exist: no such file or directory\"\n" +
+				"\t- [2] errors.Is(\"unexpected EOF\")",
+		},
+	})
+}
+
+func TestErrorAs(t *testing.T) {
+	t.Parallel()
+
+	err := grouperror.Join(pathError(), io.EOF)
+
+	run(t, []scenario{
+		{
+			name: "No errors [error]",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.ErrorAs(tb, err, nil)
+			},
+			expected: "ErrorAs: expected no error, got:\n\t\"open file does not exist: no such file or directory\\nEOF\"",
+		},
+		{
+			name: "OK",
+			check: func(tb testing.TB) bool {
+				var (
+					pathErr *os.PathError
+					anyErr  error
+				)
+
+				return grouperrortest.ErrorAs(tb, err, []interface{}{&pathErr, &anyErr})
+			},
+		},
+		{
+			name: "Error",
+			check: func(tb testing.TB) bool {
+				var (
+					anyErr    error
+					customErr *customError
+				)
+
+				return grouperrortest.ErrorAs(tb, err, []interface{}{&anyErr, &customErr})
+			},
+			expected: "ErrorAs: error group does not match (-expected +actual):\n" +
+				"\t  [0] \"open file does not exist: no such file or directory\"\n" +
+				"\t- [1] errors.As(*grouperrortest_test.customError)\n" +
+				"\t+ [1] \"EOF\"",
+		},
+	})
+}
+
+// TestImports ensures that the package depends only on the standard library and the package grouperror,
+// so tests that use it do not link testify.
+func TestImports(t *testing.T) {
+	t.Parallel()
+
+	for _, dir := range []string{".", ".."} {
+		pkg, err := build.ImportDir(dir, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, imp := range pkg.Imports {
+			stdlib := !strings.Contains(strings.Split(imp, "/")[0], ".")
+			if !stdlib && imp != "github.com/gontainer/grouperror" {
+				t.Errorf("package %s imports %s", pkg.Name, imp)
+			}
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperrortest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+)
+
+const updateFlag = "update"
+
+// UpdateEnv is the environment variable that makes [Snapshot] and [SnapshotTree] write golden files,
+// e.g. GROUPERRORTEST_UPDATE=1 go test ./...
+const UpdateEnv = "GROUPERRORTEST_UPDATE"
+
+// shouldUpdate reports whether golden files should be written. This package does not define any flags,
+// it reads the flag -update lazily, so the flag can be defined by the caller.
+func shouldUpdate() bool {
+	if ok, _ := strconv.ParseBool(os.Getenv(UpdateEnv)); ok {
+		return true
+	}
+
+	f := flag.Lookup(updateFlag)
+	if f == nil {
+		return false
+	}
+
+	g, ok := f.Value.(flag.Getter)
+	if !ok {
+		return false
+	}
+
+	v, _ := g.Get().(bool)
+
+	return v
+}
+
+/*
+Snapshot compares the messages returned by [grouperror.Collection] with the golden file "testdata/<name>.golden".
+When the environment variable [UpdateEnv] is set to a true value, Snapshot writes the golden file instead.
+The environment variable replaces a package-level -update flag, see the package documentation.
+
+	func TestValidation(t *testing.T) {
+		grouperrortest.Snapshot(t, "validation", validate(input))
+	}
+
+Golden files are written also when the test binary defines the boolean flag -update, and it is set.
+This package does not define the flag itself, so it never conflicts with flags defined by the caller:
+
+	var update = flag.Bool("update", false, "update golden files")
+*/
+func Snapshot(tb testing.TB, name string, err error) bool {
+	tb.Helper()
+
+	b, marshalErr := grouperror.MarshalJSONFlat(err)
+
+	return snapshot(tb, "Snapshot", name, b, marshalErr)
+}
+
+// SnapshotTree works the same way as [Snapshot], but it preserves the structure of the groups,
+// see [grouperror.MarshalJSON].
+func SnapshotTree(tb testing.TB, name string, err error) bool {
+	tb.Helper()
+
+	b, marshalErr := grouperror.MarshalJSON(err)
+
+	return snapshot(tb, "SnapshotTree", name, b, marshalErr)
+}
+
+func snapshot(tb testing.TB, fn string, name string, data []byte, err error) bool {
+	tb.Helper()
+
+	if err != nil {
+		tb.Errorf("%s: could not encode error: %s", fn, err.Error())
+
+		return false
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		tb.Errorf("%s: could not encode error: %s", fn, err.Error())
+
+		return false
+	}
+
+	buf.WriteString("\n")
+
+	file := filepath.Join("testdata", name+".golden")
+
+	if shouldUpdate() {
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil { //nolint:gomnd
+			tb.Errorf("%s: could not create directory: %s", fn, err.Error())
+
+			return false
+		}
+
+		if err := ioutil.WriteFile(file, buf.Bytes(), 0o644); err != nil { //nolint:gomnd,gosec,staticcheck
+			tb.Errorf("%s: could not write golden file: %s", fn, err.Error())
+
+			return false
+		}
+
+		return true
+	}
+
+	expected, err := ioutil.ReadFile(file) //nolint:staticcheck
+	if err != nil {
+		tb.Errorf("%s: could not read golden file, run tests with %s=1 to create it: %s", fn, UpdateEnv, err.Error())
+
+		return false
+	}
+
+	if bytes.Equal(expected, buf.Bytes()) {
+		return true
+	}
+
+	tb.Errorf(
+		"%s: %s does not match (-expected +actual):\n\t%s",
+		fn,
+		file,
+		strings.Join(diffLines(splitLines(string(expected)), splitLines(buf.String())), "\n\t"),
+	)
+
+	return false
+}
+
+func splitLines(s string) []string {
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines returns a line diff of the given texts, based on their longest common subsequence.
+// Lines are prefixed by "  " when they are common, by "- " when they are missing, and by "+ " when they are unexpected.
+func diffLines(expected []string, actual []string) []string {
+	// lcs[i][j] is the length of the longest common subsequence of expected[i:] and actual[j:]
+	lcs := make([][]int, len(expected)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(actual)+1)
+	}
+
+	for i := len(expected) - 1; i >= 0; i-- {
+		for j := len(actual) - 1; j >= 0; j-- {
+			switch {
+			case expected[i] == actual[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	lines := make([]string, 0, maxLen(len(expected), len(actual)))
+	i, j := 0, 0
+
+	for i < len(expected) || j < len(actual) {
+		switch {
+		case i < len(expected) && j < len(actual) && expected[i] == actual[j]:
+			lines = append(lines, "  "+expected[i])
+			i++
+			j++
+		case j == len(actual) || (i < len(expected) && lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, "- "+expected[i])
+			i++
+		default:
+			lines = append(lines, "+ "+actual[j])
+			j++
+		}
+	}
+
+	return lines
+}
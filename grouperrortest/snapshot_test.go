@@ -0,0 +1,191 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperrortest_test
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/gontainer/grouperror/grouperrortest"
+)
+
+// update is defined the same way as in the packages that use grouperrortest,
+// importing grouperrortest must not cause "flag redefined: update".
+var update = flag.Bool("update", false, "update golden files") //nolint:gochecknoglobals
+
+//nolint:goerr113
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+
+	run(t, []scenario{
+		{
+			name: "Flat [OK]",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.Snapshot(tb, "flat", grouperror.Prefix(
+					"validation: ",
+					grouperror.Prefix("person: ", errors.New("invalid name"), errors.New("invalid\nage")),
+					io.EOF,
+				))
+			},
+		},
+		{
+			name: "Tree [OK]",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.SnapshotTree(tb, "tree", grouperror.Prefix(
+					"validation: ",
+					grouperror.Prefix("person: ", errors.New("invalid name"), errors.New("invalid\nage")),
+					io.EOF,
+				))
+			},
+		},
+		{
+			name: "Flat [error]",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.Snapshot(tb, "flat", grouperror.Prefix("validation: ", io.EOF))
+			},
+			expected: "Snapshot: testdata/flat.golden does not match (-expected +actual):\n" +
+				"\t  [\n" +
+				"\t-   \"validation: person: invalid name\",\n" +
+				"\t-   \"validation: person: invalid\\nage\",\n" +
+				"\t    \"validation: EOF\"\n" +
+				"\t  ]",
+		},
+		{
+			name: "Tree [error]",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.SnapshotTree(tb, "tree", grouperror.Prefix(
+					"validation: ",
+					grouperror.Prefix("person: ", errors.New("invalid name"), errors.New("invalid\nage")),
+					io.ErrUnexpectedEOF,
+				))
+			},
+			expected: "SnapshotTree: testdata/tree.golden does not match (-expected +actual):\n" +
+				"\t  {\n" +
+				"\t    \"prefix\": \"validation: \",\n" +
+				"\t    \"errors\": [\n" +
+				"\t      {\n" +
+				"\t        \"prefix\": \"person: \",\n" +
+				"\t        \"errors\": [\n" +
+				"\t          {\n" +
+				"\t            \"message\": \"invalid name\",\n" +
+				"\t            \"type\": \"*errors.errorString\"\n" +
+				"\t          },\n" +
+				"\t          {\n" +
+				"\t            \"message\": \"invalid\\nage\",\n" +
+				"\t            \"type\": \"*errors.errorString\"\n" +
+				"\t          }\n" +
+				"\t        ]\n" +
+				"\t      },\n" +
+				"\t      {\n" +
+				"\t-       \"message\": \"EOF\",\n" +
+				"\t+       \"message\": \"unexpected EOF\",\n" +
+				"\t        \"type\": \"*errors.errorString\"\n" +
+				"\t      }\n" +
+				"\t    ]\n" +
+				"\t  }",
+		},
+		{
+			name: "Missing file",
+			check: func(tb testing.TB) bool {
+				return grouperrortest.Snapshot(tb, "missing", io.EOF)
+			},
+			expected: "Snapshot: could not read golden file, run tests with GROUPERRORTEST_UPDATE=1 to create it: " +
+				"open testdata/missing.golden: no such file or directory",
+		},
+	})
+}
+
+// inTempDir runs the given function in a temporary working directory.
+func inTempDir(t *testing.T, fn func(dir string)) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "grouperrortest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = os.Chdir(wd)
+	}()
+
+	fn(dir)
+}
+
+func assertUpdated(t *testing.T, dir string, name string) {
+	t.Helper()
+
+	if !grouperrortest.Snapshot(t, name, grouperror.Join(io.EOF, io.ErrUnexpectedEOF)) {
+		t.Fatal("unexpected failure")
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "testdata", name+".golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := "[\n  \"EOF\",\n  \"unexpected EOF\"\n]\n"; string(b) != expected {
+		t.Errorf("unexpected golden file:\n%s\nexpected:\n%s", b, expected)
+	}
+}
+
+//nolint:paralleltest
+func TestSnapshot_update(t *testing.T) {
+	t.Run("Flag defined by the caller", func(t *testing.T) {
+		inTempDir(t, func(dir string) {
+			*update = true
+
+			defer func() {
+				*update = false
+			}()
+
+			assertUpdated(t, dir, "flag")
+		})
+	})
+
+	t.Run("Environment variable", func(t *testing.T) {
+		inTempDir(t, func(dir string) {
+			if err := os.Setenv(grouperrortest.UpdateEnv, "1"); err != nil {
+				t.Fatal(err)
+			}
+
+			defer os.Unsetenv(grouperrortest.UpdateEnv)
+
+			assertUpdated(t, dir, "env")
+		})
+	})
+}
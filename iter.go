@@ -0,0 +1,59 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.23
+// +build go1.23
+
+package grouperror
+
+import (
+	"iter"
+)
+
+/*
+All returns an iterator over the errors returned by [Collection], in the same order.
+Contrary to [Collection], it does not allocate the whole collection, and it creates at most one wrapper per error.
+
+	for err := range grouperror.All(err) {
+	    fmt.Println(err)
+	}
+*/
+func All(err error) iter.Seq[error] {
+	return func(yield func(error) bool) {
+		eachLeaf(err, "", nil, func(l Leaf) bool {
+			return yield(prefixed(l.Prefix, l.Err))
+		})
+	}
+}
+
+/*
+Entries returns an iterator over the accumulated prefixes and the original errors, see [Leaves].
+
+	for prefix, err := range grouperror.Entries(err) {
+	    fmt.Printf("%q %s\n", prefix, err)
+	}
+*/
+func Entries(err error) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		eachLeaf(err, "", nil, func(l Leaf) bool {
+			return yield(l.Prefix, l.Err)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.23
+// +build go1.23
+
+package grouperror_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:goerr113
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		for range grouperror.All(nil) {
+			t.Fatal("unexpected iteration")
+		}
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Prefix(
+			"my group: ",
+			grouperror.Prefix("some errors: ", io.EOF, io.ErrNoProgress),
+			io.ErrUnexpectedEOF,
+			&wrappedError{error: errors.New("wrapped")},
+		)
+		collection := grouperror.Collection(err)
+
+		i := 0
+		for x := range grouperror.All(err) {
+			assert.Equal(t, collection[i].Error(), x.Error())
+			i++
+		}
+
+		assert.Equal(t, len(collection), i)
+	})
+
+	t.Run("Break", func(t *testing.T) {
+		t.Parallel()
+
+		group := grouperror.Prefix(
+			"my group: ",
+			grouperror.Prefix("some errors: ", io.EOF, io.ErrNoProgress),
+			io.ErrUnexpectedEOF,
+			&wrappedError{error: errors.New("wrapped")},
+		)
+
+		var msgs []string
+		for x := range grouperror.All(group) {
+			msgs = append(msgs, x.Error())
+			if len(msgs) == 2 {
+				break
+			}
+		}
+
+		assert.Equal(t, []string{"my group: some errors: EOF", "my group: some errors: multiple Read calls return no data or error"}, msgs)
+	})
+}
+
+//nolint:goerr113
+func TestEntries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		group := grouperror.Prefix(
+			"my group: ",
+			grouperror.Prefix("some errors: ", io.EOF, io.ErrNoProgress),
+			io.ErrUnexpectedEOF,
+			&wrappedError{error: errors.New("wrapped")},
+		)
+
+		var (
+			prefixes []string
+			errs     []error
+		)
+
+		for prefix, err := range grouperror.Entries(group) {
+			prefixes = append(prefixes, prefix)
+			errs = append(errs, err)
+
+			if len(errs) == 3 {
+				break
+			}
+		}
+
+		assert.Equal(t, []string{"my group: some errors: ", "my group: some errors: ", "my group: "}, prefixes)
+		assert.Equal(t, []error{io.EOF, io.ErrNoProgress, io.ErrUnexpectedEOF}, errs)
+	})
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// jsonNode is used to decode a group or a single error.
+// Pointers distinguish absent keys from empty values.
+type jsonNode struct {
+	Prefix  *string     `json:"prefix"`
+	Limit   *int        `json:"limit"`
+	Errors  []*jsonNode `json:"errors"`
+	Message *string     `json:"message"`
+	Type    *string     `json:"type"`
+}
+
+// jsonGroup is a JSON representation of a group, the prefix is always present.
+// The limit is present only for groups limited by [Limit].
+type jsonGroup struct {
+	Prefix string `json:"prefix"`
+	Limit  *int   `json:"limit,omitempty"`
+	Errors []any  `json:"errors"`
+}
+
+// jsonLeaf is a JSON representation of a single error.
+type jsonLeaf struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+}
+
+func toJSON(err error) any {
+	if l, ok := err.(*limitError); ok { //nolint:errorlint
+		n := l.n
+		inner := toJSON(l.err)
+
+		if g, ok := inner.(jsonGroup); ok && g.Limit == nil {
+			g.Limit = &n
+
+			return g
+		}
+
+		return jsonGroup{
+			Limit:  &n,
+			Errors: []any{inner},
+		}
+	}
+
+	g, ok := groupOf(err)
+	if !ok {
+		typ := fmt.Sprintf("%T", err)
+		if t, ok := err.(interface{ Type() string }); ok { //nolint:errorlint
+			typ = t.Type()
+		}
+
+		return jsonLeaf{
+			Message: err.Error(),
+			Type:    typ,
+		}
+	}
+
+	errs := make([]any, 0, len(g.errors))
+
+	for _, x := range g.errors {
+		if x != nil {
+			errs = append(errs, toJSON(x))
+		}
+	}
+
+	return jsonGroup{
+		Prefix: g.prefix,
+		Errors: errs,
+	}
+}
+
+// MarshalJSON implements [json.Marshaler], see [MarshalJSON].
+func (g *groupError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(toJSON(g)) //nolint:wrapcheck
+}
+
+/*
+MarshalJSON returns the JSON encoding of the given error. Groups are encoded as objects
+with the keys "prefix" and "errors", single errors are encoded as objects with the keys "message" and "type".
+Groups limited by [Limit] have also the key "limit".
+The type of a single error is the result of its method `Type() string` if it exists, e.g. for errors decoded
+by [UnmarshalJSON], so the output of MarshalJSON is stable through decoding and encoding again.
+It returns "null" for nil.
+
+	err := grouperror.Prefix("my group: ", errors.New("error1"), errors.New("error2"))
+	b, _ := grouperror.MarshalJSON(err)
+	fmt.Println(string(b))
+	// Output:
+	// {"prefix":"my group: ","errors":[{"message":"error1","type":"*errors.errorString"},{"message":"error2","type":"*errors.errorString"}]}
+
+See [UnmarshalJSON].
+See [MarshalJSONFlat].
+*/
+func MarshalJSON(err error) ([]byte, error) {
+	if err == nil {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(toJSON(err)) //nolint:wrapcheck
+}
+
+// MarshalJSONFlat returns the JSON encoding of the messages of the errors returned by [Collection].
+// It returns an empty array for nil.
+func MarshalJSONFlat(err error) ([]byte, error) {
+	c := Collection(err)
+	msgs := make([]string, 0, len(c))
+
+	for _, x := range c {
+		msgs = append(msgs, x.Error())
+	}
+
+	return json.Marshal(msgs) //nolint:wrapcheck
+}
+
+// decodedError is a single error decoded by [UnmarshalJSON].
+type decodedError struct {
+	message string
+	typ     string
+}
+
+func (d *decodedError) Error() string {
+	return d.message
+}
+
+// Type returns the type of the original error.
+func (d *decodedError) Type() string {
+	return d.typ
+}
+
+// fromJSON decodes the given node, at is a JSON pointer to the node, used in error messages.
+func fromJSON(n *jsonNode, at string) (error, error) { //nolint:revive,stylecheck
+	if n == nil {
+		if at == "" {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("invalid node at %s: null", at) //nolint:goerr113
+	}
+
+	where := at
+	if where == "" {
+		where = "/"
+	}
+
+	if n.Errors == nil {
+		if n.Message == nil {
+			return nil, fmt.Errorf(`invalid node at %s: missing "errors" or "message"`, where) //nolint:goerr113
+		}
+
+		if n.Prefix != nil || n.Limit != nil {
+			return nil, fmt.Errorf(`invalid node at %s: "prefix" and "limit" require "errors"`, where) //nolint:goerr113
+		}
+
+		d := &decodedError{message: *n.Message}
+		if n.Type != nil {
+			d.typ = *n.Type
+		}
+
+		return d, nil
+	}
+
+	if n.Message != nil || n.Type != nil {
+		return nil, fmt.Errorf(`invalid node at %s: "errors" cannot be mixed with "message" or "type"`, where) //nolint:goerr113
+	}
+
+	errs := make([]error, 0, len(n.Errors))
+
+	for i, x := range n.Errors {
+		err, decodeErr := fromJSON(x, fmt.Sprintf("%s/errors/%d", at, i))
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+
+		errs = append(errs, err)
+	}
+
+	prefix := ""
+	if n.Prefix != nil {
+		prefix = *n.Prefix
+	}
+
+	if n.Limit == nil {
+		return Prefix(prefix, errs...), nil
+	}
+
+	if *n.Limit < 0 {
+		return nil, fmt.Errorf(`invalid node at %s: negative "limit"`, where) //nolint:goerr113
+	}
+
+	return Limit(Prefix(prefix, errs...), *n.Limit), nil
+}
+
+/*
+UnmarshalJSON decodes an error encoded by [MarshalJSON].
+The decoded error returns the same values from Error() and [Collection] as the original one.
+Single errors are decoded to errors that implement `interface{ Type() string }`,
+the method returns the type of the original error.
+
+The second returned value is not nil when the given data is not a valid JSON document,
+or when it contains a node that is neither a group nor a single error, e.g. `{}`,
+or a node that has both "errors" and "message".
+An empty group, e.g. `{"prefix":"","errors":[]}`, is decoded to nil, the same way [Prefix] returns nil for no errors.
+*/
+func UnmarshalJSON(data []byte) (error, error) { //nolint:revive,stylecheck
+	var n *jsonNode
+	if err := json.Unmarshal(data, &n); err != nil {
+		return nil, fmt.Errorf("grouperror: could not decode JSON: %w", err)
+	}
+
+	err, decodeErr := fromJSON(n, "")
+	if decodeErr != nil {
+		return nil, fmt.Errorf("grouperror: could not decode JSON: %w", decodeErr)
+	}
+
+	return err, nil
+}
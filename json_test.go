@@ -0,0 +1,332 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:goerr113
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := grouperror.MarshalJSON(nil)
+		require.NoError(t, err)
+		assert.Equal(t, "null", string(b))
+	})
+
+	t.Run("Single error", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := grouperror.MarshalJSON(io.EOF)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"message":"EOF","type":"*errors.errorString"}`, string(b))
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		group := grouperror.Prefix(
+			"validation: ",
+			grouperror.Prefix(
+				"person: ",
+				errors.New("invalid name"),
+				io.EOF,
+			),
+			&wrappedError{error: errors.New("unexpected error")},
+			grouperror.Join(errors.New("invalid age")),
+		)
+
+		expected := `{
+	"prefix": "validation: ",
+	"errors": [
+		{
+			"prefix": "person: ",
+			"errors": [
+				{"message": "invalid name", "type": "*errors.errorString"},
+				{"message": "EOF", "type": "*errors.errorString"}
+			]
+		},
+		{
+			"prefix": "",
+			"errors": [
+				{"message": "unexpected error", "type": "*errors.errorString"}
+			]
+		},
+		{
+			"prefix": "",
+			"errors": [
+				{"message": "invalid age", "type": "*errors.errorString"}
+			]
+		}
+	]
+}`
+
+		b, err := grouperror.MarshalJSON(group)
+		require.NoError(t, err)
+		assert.JSONEq(t, expected, string(b))
+
+		b, err = json.Marshal(struct {
+			Err error `json:"err"`
+		}{
+			Err: group,
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"err":`+expected+`}`, string(b))
+	})
+}
+
+func TestMarshalJSON_limit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := grouperror.MarshalJSON(grouperror.Limit(grouperror.Prefix("p: ", io.EOF, io.ErrUnexpectedEOF), 1))
+		require.NoError(t, err)
+		assert.JSONEq(
+			t,
+			`{
+	"prefix": "p: ",
+	"limit": 1,
+	"errors": [
+		{"message": "EOF", "type": "*errors.errorString"},
+		{"message": "unexpected EOF", "type": "*errors.errorString"}
+	]
+}`,
+			string(b),
+		)
+	})
+
+	t.Run("Single error", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := grouperror.MarshalJSON(grouperror.Limit(io.EOF, 0))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"prefix":"","limit":0,"errors":[{"message":"EOF","type":"*errors.errorString"}]}`, string(b))
+	})
+}
+
+//nolint:goerr113
+func TestMarshalJSONFlat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := grouperror.MarshalJSONFlat(nil)
+		require.NoError(t, err)
+		assert.Equal(t, "[]", string(b))
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		group := grouperror.Prefix(
+			"validation: ",
+			grouperror.Prefix(
+				"person: ",
+				errors.New("invalid name"),
+				io.EOF,
+			),
+			&wrappedError{error: errors.New("unexpected error")},
+			grouperror.Join(errors.New("invalid age")),
+		)
+
+		b, err := grouperror.MarshalJSONFlat(group)
+		require.NoError(t, err)
+		assert.JSONEq(
+			t,
+			`[
+	"validation: person: invalid name",
+	"validation: person: EOF",
+	"validation: unexpected error",
+	"validation: invalid age"
+]`,
+			string(b),
+		)
+	})
+}
+
+//nolint:goerr113
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Null", func(t *testing.T) {
+		t.Parallel()
+
+		err, decodeErr := grouperror.UnmarshalJSON([]byte("null"))
+		require.NoError(t, decodeErr)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		t.Parallel()
+
+		_, decodeErr := grouperror.UnmarshalJSON([]byte("{"))
+		require.EqualError(t, decodeErr, "grouperror: could not decode JSON: unexpected end of JSON input")
+	})
+
+	t.Run("Invalid nodes", func(t *testing.T) {
+		t.Parallel()
+
+		scenarios := map[string]struct {
+			input string
+			error string
+		}{
+			"Empty object": {
+				input: `{}`,
+				error: `grouperror: could not decode JSON: invalid node at /: missing "errors" or "message"`,
+			},
+			"Errors and message": {
+				input: `{"message":"m","type":"t","errors":[]}`,
+				error: `grouperror: could not decode JSON: invalid node at /: "errors" cannot be mixed with "message" or "type"`,
+			},
+			"Prefix without errors": {
+				input: `{"prefix":"p: ","message":"m"}`,
+				error: `grouperror: could not decode JSON: invalid node at /: "prefix" and "limit" require "errors"`,
+			},
+			"Limit without errors": {
+				input: `{"limit":1,"message":"m"}`,
+				error: `grouperror: could not decode JSON: invalid node at /: "prefix" and "limit" require "errors"`,
+			},
+			"Negative limit": {
+				input: `{"prefix":"","limit":-1,"errors":[{"message":"m"}]}`,
+				error: `grouperror: could not decode JSON: invalid node at /: negative "limit"`,
+			},
+			"Nested null": {
+				input: `{"prefix":"","errors":[{"message":"m","type":"t"},null]}`,
+				error: `grouperror: could not decode JSON: invalid node at /errors/1: null`,
+			},
+			"Nested empty object": {
+				input: `{"prefix":"","errors":[{"prefix":"p: ","errors":[{}]}]}`,
+				error: `grouperror: could not decode JSON: invalid node at /errors/0/errors/0: missing "errors" or "message"`,
+			},
+		}
+
+		for name, s := range scenarios {
+			s := s
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				err, decodeErr := grouperror.UnmarshalJSON([]byte(s.input))
+				require.EqualError(t, decodeErr, s.error)
+				assert.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("Empty group", func(t *testing.T) {
+		t.Parallel()
+
+		err, decodeErr := grouperror.UnmarshalJSON([]byte(`{"prefix":"p: ","errors":[]}`))
+		require.NoError(t, decodeErr)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Single error", func(t *testing.T) {
+		t.Parallel()
+
+		err, decodeErr := grouperror.UnmarshalJSON([]byte(`{"message":"EOF","type":"*errors.errorString"}`))
+		require.NoError(t, decodeErr)
+		require.EqualError(t, err, "EOF")
+
+		var typed interface{ Type() string }
+		require.ErrorAs(t, err, &typed)
+		assert.Equal(t, "*errors.errorString", typed.Type())
+	})
+
+	t.Run("Encode decoded", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := grouperror.MarshalJSON(grouperror.Prefix("p: ", io.EOF, &wrappedError{error: io.ErrUnexpectedEOF}))
+		require.NoError(t, err)
+
+		decoded, decodeErr := grouperror.UnmarshalJSON(b)
+		require.NoError(t, decodeErr)
+
+		again, err := grouperror.MarshalJSON(decoded)
+		require.NoError(t, err)
+		assert.Equal(t, string(b), string(again))
+		assert.Contains(t, string(again), `"type":"*errors.errorString"`)
+	})
+
+	t.Run("Round trip with limits", func(t *testing.T) {
+		t.Parallel()
+
+		group := grouperror.Prefix("my group: ", io.EOF, io.ErrUnexpectedEOF, io.ErrClosedPipe)
+
+		for _, original := range []error{
+			grouperror.Limit(group, 1),
+			grouperror.Limit(grouperror.Limit(group, 2), 1),
+			grouperror.Prefix("import: ", grouperror.Limit(group, 1), io.ErrShortWrite),
+			grouperror.Limit(io.EOF, 0),
+		} {
+			b, err := grouperror.MarshalJSON(original)
+			require.NoError(t, err)
+
+			decoded, decodeErr := grouperror.UnmarshalJSON(b)
+			require.NoError(t, decodeErr)
+			assert.Equal(t, original.Error(), decoded.Error())
+			assert.Len(t, grouperror.Collection(decoded), len(grouperror.Collection(original)))
+		}
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		t.Parallel()
+
+		original := grouperror.Prefix(
+			"validation: ",
+			grouperror.Prefix(
+				"person: ",
+				errors.New("invalid name"),
+				io.EOF,
+			),
+			&wrappedError{error: errors.New("unexpected error")},
+			grouperror.Join(errors.New("invalid age")),
+		)
+
+		b, err := grouperror.MarshalJSON(original)
+		require.NoError(t, err)
+
+		decoded, decodeErr := grouperror.UnmarshalJSON(b)
+		require.NoError(t, decodeErr)
+		assert.Equal(t, original.Error(), decoded.Error())
+
+		expected := grouperror.Collection(original)
+		actual := grouperror.Collection(decoded)
+		require.Len(t, actual, len(expected))
+		for i := range expected {
+			assert.Equal(t, expected[i].Error(), actual[i].Error())
+		}
+	})
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"strings"
+)
+
+// Leaf describes a single error of a group.
+type Leaf struct {
+	// Prefix is the accumulated prefix of all the groups the error belongs to.
+	Prefix string
+	// Path is the accumulated path of all the groups the error belongs to, see [PrefixPath].
+	Path Path
+	// Err is the original error, without any prefix.
+	Err error
+}
+
+/*
+Leaves returns all the errors from the given group together with their prefixes and paths.
+It returns the same errors as [Collection], in the same order, but it does not add prefixes to them.
+For each leaf, `leaf.Prefix + leaf.Err.Error()` is equal to the message of the corresponding element of [Collection].
+
+	err := grouperror.PrefixPath(
+	    grouperror.Path{grouperror.Field("people"), grouperror.Index(2)},
+	    grouperror.PrefixPath(grouperror.Path{grouperror.Field("name")}, errors.New("required")),
+	)
+	for _, l := range grouperror.Leaves(err) {
+	    fmt.Println(l.Path, l.Err)
+	}
+	// Output:
+	// people[2].name required
+*/
+func Leaves(err error) []Leaf {
+	var leaves []Leaf
+
+	eachLeaf(err, "", nil, func(l Leaf) bool {
+		leaves = append(leaves, l)
+
+		return true
+	})
+
+	return leaves
+}
+
+// groupOf returns the given error as a group, if the error is a group.
+func groupOf(err error) (*groupError, bool) {
+	switch e := err.(type) { //nolint:errorlint
+	case *groupError:
+		return e, true
+	case *limitError:
+		return groupOf(e.err)
+	case interface{ Collection() []error }:
+		return &groupError{errors: filterNil(e.Collection())}, true
+	case interface{ Unwrap() []error }:
+		errs := filterNil(e.Unwrap())
+		if !isJoinError(err) && !isJoined(err, errs) {
+			return nil, false
+		}
+
+		return &groupError{errors: errs}, true
+	}
+
+	return nil, false
+}
+
+// isJoined reports whether the message of the given error consists of the messages of the given errors
+// joined with "\n", as for [errors.Join]. Other errors, e.g. created by [fmt.Errorf] with multiple %w verbs,
+// have their own messages, and they are not groups.
+// It renders all the messages, so it is used only for the types other than the one returned by [errors.Join].
+func isJoined(err error, errs []error) bool {
+	if len(errs) == 0 {
+		return false
+	}
+
+	msg := err.Error()
+
+	for i, x := range errs {
+		if i > 0 {
+			if !strings.HasPrefix(msg, "\n") {
+				return false
+			}
+
+			msg = msg[1:]
+		}
+
+		m := x.Error()
+		if !strings.HasPrefix(msg, m) {
+			return false
+		}
+
+		msg = msg[len(m):]
+	}
+
+	return msg == ""
+}
+
+// eachLeaf calls yield for each leaf of the given error until yield returns false.
+// It returns false, when the iteration has been stopped.
+func eachLeaf(err error, prefix string, path Path, yield func(Leaf) bool) bool {
+	if err == nil {
+		return true
+	}
+
+	g, ok := groupOf(err)
+	if !ok {
+		return yield(Leaf{Prefix: prefix, Path: path, Err: err})
+	}
+
+	prefix += g.prefix
+	path = path.join(g.path)
+
+	for _, x := range g.errors {
+		if !eachLeaf(x, prefix, path, yield) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:goerr113
+func TestLeaves(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, grouperror.Leaves(nil))
+	})
+
+	t.Run("Single error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []grouperror.Leaf{{Err: io.EOF}}, grouperror.Leaves(io.EOF))
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		errName := errors.New("required")
+		errID := errors.New("the given ID does not exist")
+
+		err := grouperror.Prefix(
+			"Validation: ",
+			grouperror.PrefixPath(
+				grouperror.Path{grouperror.Field("person")},
+				grouperror.PrefixPath(grouperror.Path{grouperror.Field("name")}, errName),
+				errID,
+			),
+			&wrappedError{error: grouperror.Prefix("wrapped: ", io.EOF)},
+			io.ErrUnexpectedEOF,
+		)
+
+		expected := []grouperror.Leaf{
+			{
+				Prefix: "Validation: person: name: ",
+				Path:   grouperror.Path{grouperror.Field("person"), grouperror.Field("name")},
+				Err:    errName,
+			},
+			{
+				Prefix: "Validation: person: ",
+				Path:   grouperror.Path{grouperror.Field("person")},
+				Err:    errID,
+			},
+			{
+				Prefix: "Validation: wrapped: ",
+				Err:    io.EOF,
+			},
+			{
+				Prefix: "Validation: ",
+				Err:    io.ErrUnexpectedEOF,
+			},
+		}
+
+		leaves := grouperror.Leaves(err)
+		assert.Equal(t, expected, leaves)
+
+		collection := grouperror.Collection(err)
+		require.Len(t, collection, len(leaves))
+		for i, l := range leaves {
+			assert.Same(t, expected[i].Err, l.Err)
+			assert.EqualError(t, collection[i], l.Prefix+l.Err.Error()) //nolint:testifylint
+		}
+	})
+}
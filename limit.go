@@ -0,0 +1,177 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+Limit limits the number of errors rendered by Error() to n.
+The remaining errors are summarized in the last line.
+The full set of errors remains available through [Collection], [errors.Is], and [errors.As].
+It returns the given error, when n is negative.
+
+	err := grouperror.Join(errors.New("error1"), errors.New("error2"), errors.New("error3"))
+	fmt.Println(grouperror.Limit(err, 1))
+	// Output:
+	// error1
+	// ... and 2 more errors
+
+The limit is kept when the returned error is nested in other groups,
+the summary line gets the prefix of the enclosing groups:
+
+	fmt.Println(grouperror.Prefix("import: ", grouperror.Limit(err, 1)))
+	// Output:
+	// import: error1
+	// import: ... and 2 more errors
+
+The limit is also kept by %+v, see [fmt.Formatter], by [MarshalJSON] and [UnmarshalJSON],
+and by the [slog.LogValuer] implemented by this package.
+[Dedup] keeps only the outermost limit, because it flattens the groups.
+*/
+func Limit(err error, n int) error {
+	if err == nil || n < 0 {
+		return err
+	}
+
+	return &limitError{
+		err: err,
+		n:   n,
+	}
+}
+
+type limitError struct {
+	err error
+	n   int
+}
+
+func (l *limitError) Error() string {
+	return render(l)
+}
+
+func (l *limitError) Unwrap() error {
+	return l.err
+}
+
+func (l *limitError) Collection() []error {
+	return Collection(l.err)
+}
+
+// Format implements [fmt.Formatter], see [Limit].
+func (l *limitError) Format(s fmt.State, verb rune) {
+	format(s, verb, l, l.tree, l.goString)
+}
+
+func (l *limitError) tree() string {
+	return strings.Join(appendTree([]string{fmt.Sprintf("limit %d", l.n)}, []error{l.err}, ""), "\n")
+}
+
+func (l *limitError) goString() string {
+	return fmt.Sprintf("grouperror.Limit(%#v, %d)", l.err, l.n)
+}
+
+func moreErrors(n int) string {
+	if n == 1 {
+		return "... and 1 more error"
+	}
+
+	return fmt.Sprintf("... and %d more errors", n)
+}
+
+// eachVisible calls yield for each leaf rendered by Error(). The errors hidden by [Limit] are summarized
+// by a single call with hidden > 0, and the prefix and the path of the limited group.
+func eachVisible(err error, prefix string, path Path, yield func(l Leaf, hidden int)) {
+	if err == nil {
+		return
+	}
+
+	if l, ok := err.(*limitError); ok { //nolint:errorlint
+		shown, hidden := 0, 0
+
+		eachVisible(l.err, prefix, path, func(x Leaf, h int) {
+			switch {
+			case shown < l.n:
+				yield(x, h)
+
+				if h == 0 {
+					shown++
+				}
+			case h > 0:
+				hidden += h
+			default:
+				hidden++
+			}
+		})
+
+		if hidden > 0 {
+			yield(Leaf{Prefix: prefix, Path: path}, hidden)
+		}
+
+		return
+	}
+
+	g, ok := groupOf(err)
+	if !ok {
+		yield(Leaf{Prefix: prefix, Path: path, Err: err}, 0)
+
+		return
+	}
+
+	prefix += g.prefix
+	path = path.join(g.path)
+
+	for _, x := range g.errors {
+		eachVisible(x, prefix, path, yield)
+	}
+}
+
+// render renders the given error one leaf per line, see [Limit].
+func render(err error) string {
+	var b strings.Builder
+
+	first := true
+
+	eachVisible(err, "", nil, func(l Leaf, hidden int) {
+		if !first {
+			b.WriteString("\n")
+		}
+
+		first = false
+
+		b.WriteString(l.Prefix)
+
+		if hidden > 0 {
+			b.WriteString(moreErrors(hidden))
+		} else {
+			b.WriteString(l.Err.Error())
+		}
+	})
+
+	return b.String()
+}
+
+func isLimit(err error) bool {
+	_, ok := err.(*limitError) //nolint:errorlint
+
+	return ok
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:goerr113
+func TestLimit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, grouperror.Limit(nil, 5))
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Join(io.EOF)
+		assert.Same(t, err, grouperror.Limit(err, -1))
+	})
+
+	t.Run("Single error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.EqualError(t, grouperror.Limit(io.EOF, 1), "EOF")
+		assert.EqualError(t, grouperror.Limit(io.EOF, 0), "... and 1 more error")
+	})
+
+	var errs []error
+	for i := 1; i <= 10; i++ {
+		errs = append(errs, fmt.Errorf("error #%d", i))
+	}
+
+	errs = append(errs, io.EOF)
+	group := grouperror.Prefix("my group: ", errs...)
+
+	scenarios := []struct {
+		n        int
+		expected string
+	}{
+		{
+			n:        0,
+			expected: "... and 11 more errors",
+		},
+		{
+			n: 2,
+			expected: "my group: error #1\n" +
+				"my group: error #2\n" +
+				"... and 9 more errors",
+		},
+		{
+			n:        10,
+			expected: group.Error()[:len(group.Error())-len("\nmy group: EOF")] + "\n... and 1 more error",
+		},
+		{
+			n:        11,
+			expected: group.Error(),
+		},
+		{
+			n:        100,
+			expected: group.Error(),
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+
+		t.Run(fmt.Sprintf("Limit %d", s.n), func(t *testing.T) {
+			t.Parallel()
+
+			err := grouperror.Limit(group, s.n)
+			assert.EqualError(t, err, s.expected)
+			assert.Len(t, grouperror.Collection(err), len(errs))
+			assert.Len(t, grouperror.Leaves(err), len(errs))
+			assert.ErrorIs(t, err, io.EOF)
+			assert.Same(t, group, errors.Unwrap(err))
+		})
+	}
+}
+
+//nolint:goerr113
+func TestLimit_nested(t *testing.T) {
+	t.Parallel()
+
+	group := grouperror.Join(errors.New("error #1"), errors.New("error #2"), errors.New("error #3"))
+
+	t.Run("Prefix", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Prefix("import: ", grouperror.Limit(group, 1), io.EOF)
+		assert.EqualError(t, err, "import: error #1\nimport: ... and 2 more errors\nimport: EOF")
+		assert.Len(t, grouperror.Collection(err), 4)
+	})
+
+	t.Run("Limits in a limit", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Limit(
+			grouperror.Join(grouperror.Limit(group, 1), grouperror.Prefix("other: ", io.EOF, io.ErrUnexpectedEOF)),
+			2,
+		)
+		assert.EqualError(t, err, "error #1\n... and 2 more errors\nother: EOF\n... and 1 more error")
+	})
+
+	t.Run("Format", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Prefix("import: ", grouperror.Limit(group, 1))
+		assert.Equal(
+			t,
+			`group "import: "
+└── limit 1
+    └── group ""
+        ├── error #1
+        ├── error #2
+        └── error #3`,
+			fmt.Sprintf("%+v", err),
+		)
+		assert.Equal(t, "import: error #1\nimport: ... and 2 more errors", fmt.Sprintf("%v", err))
+		assert.Equal(t, `"... and 3 more errors"`, fmt.Sprintf("%q", grouperror.Limit(group, 0)))
+	})
+
+	t.Run("Dedup", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Dedup(grouperror.Limit(grouperror.Join(io.EOF, io.EOF, io.ErrUnexpectedEOF), 1))
+		assert.EqualError(t, err, "EOF (x2)\n... and 1 more error")
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SegmentKind describes the type of [Segment].
+type SegmentKind int
+
+const (
+	// SegmentField is a name of a field, see [Field].
+	SegmentField SegmentKind = iota + 1
+	// SegmentIndex is an index in a slice or an array, see [Index].
+	SegmentIndex
+	// SegmentKey is a key in a map, see [Key].
+	SegmentKey
+)
+
+// Segment is a single element of a [Path].
+type Segment struct {
+	kind  SegmentKind
+	field string
+	index int
+	key   any
+}
+
+// Field returns a segment that points to a field.
+func Field(name string) Segment {
+	return Segment{kind: SegmentField, field: name}
+}
+
+// Index returns a segment that points to an element of a slice or an array.
+func Index(i int) Segment {
+	return Segment{kind: SegmentIndex, index: i}
+}
+
+// Key returns a segment that points to an element of a map.
+func Key(key any) Segment {
+	return Segment{kind: SegmentKey, key: key}
+}
+
+// Kind returns the kind of the segment.
+func (s Segment) Kind() SegmentKind {
+	return s.kind
+}
+
+// Value returns the name of the field, the index, or the key, depending on the kind of the segment.
+func (s Segment) Value() any {
+	switch s.kind {
+	case SegmentField:
+		return s.field
+	case SegmentIndex:
+		return s.index
+	case SegmentKey:
+		return s.key
+	}
+
+	return nil
+}
+
+// String returns `name` for fields, `[1]` for indices, and `["key"]` for keys.
+func (s Segment) String() string {
+	switch s.kind {
+	case SegmentField:
+		return s.field
+	case SegmentIndex:
+		return "[" + strconv.Itoa(s.index) + "]"
+	case SegmentKey:
+		if k, ok := s.key.(string); ok {
+			return "[" + strconv.Quote(k) + "]"
+		}
+
+		return fmt.Sprintf("[%v]", s.key)
+	}
+
+	return ""
+}
+
+// Path describes the location of an error, e.g. in a nested data structure.
+//
+//	grouperror.Path{grouperror.Field("people"), grouperror.Index(2), grouperror.Field("name")}
+//	// people[2].name
+type Path []Segment
+
+// String renders the path, e.g. `people[2].name`, or `config["timeout"]`.
+func (p Path) String() string {
+	var b strings.Builder
+
+	for i, s := range p {
+		if i > 0 && s.kind == SegmentField {
+			b.WriteString(".")
+		}
+
+		b.WriteString(s.String())
+	}
+
+	return b.String()
+}
+
+// join returns a new path that consists of both paths.
+func (p Path) join(q Path) Path {
+	if len(q) == 0 {
+		return p
+	}
+
+	r := make(Path, 0, len(p)+len(q))
+	r = append(r, p...)
+
+	return append(r, q...)
+}
+
+/*
+PrefixPath joins errors the same way as [Join], and assigns the given path to the group.
+The path is rendered as a prefix, so the following calls produce the same messages:
+
+	grouperror.PrefixPath(grouperror.Path{grouperror.Field("people"), grouperror.Index(2)}, err)
+	grouperror.Prefix("people[2]: ", err)
+
+Contrary to [Prefix], the structure of the path remains available through [Leaves].
+*/
+func PrefixPath(path Path, errs ...error) error {
+	filtered := filterNil(errs)
+	if filtered == nil {
+		return nil
+	}
+
+	var prefix string
+	if len(path) > 0 {
+		prefix = path.String() + ": "
+	}
+
+	return &groupError{
+		prefix: prefix,
+		path:   append(Path(nil), path...),
+		errors: filtered,
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPath_String(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		path     grouperror.Path
+		expected string
+	}{
+		{
+			path:     nil,
+			expected: "",
+		},
+		{
+			path:     grouperror.Path{grouperror.Field("name")},
+			expected: "name",
+		},
+		{
+			path:     grouperror.Path{grouperror.Field("people"), grouperror.Index(2), grouperror.Field("name")},
+			expected: "people[2].name",
+		},
+		{
+			path:     grouperror.Path{grouperror.Index(0), grouperror.Index(1)},
+			expected: "[0][1]",
+		},
+		{
+			path:     grouperror.Path{grouperror.Field("config"), grouperror.Key("timeout"), grouperror.Key(5)},
+			expected: `config["timeout"][5]`,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+
+		t.Run(s.expected, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, s.expected, s.path.String())
+		})
+	}
+}
+
+func TestSegment(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, grouperror.SegmentField, grouperror.Field("name").Kind())
+	assert.Equal(t, "name", grouperror.Field("name").Value())
+	assert.Equal(t, grouperror.SegmentIndex, grouperror.Index(3).Kind())
+	assert.Equal(t, 3, grouperror.Index(3).Value())
+	assert.Equal(t, grouperror.SegmentKey, grouperror.Key("k").Kind())
+	assert.Equal(t, "k", grouperror.Key("k").Value())
+	assert.Nil(t, grouperror.Segment{}.Value())
+	assert.Empty(t, grouperror.Segment{}.String())
+}
+
+//nolint:goerr113
+func TestPrefixPath(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, grouperror.PrefixPath(grouperror.Path{grouperror.Field("name")}, nil))
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		path := grouperror.Path{grouperror.Field("people"), grouperror.Index(2)}
+		err := grouperror.Prefix(
+			"validation: ",
+			grouperror.PrefixPath(
+				path,
+				grouperror.PrefixPath(grouperror.Path{grouperror.Field("name")}, errors.New("required")),
+				grouperror.PrefixPath(nil, errors.New("unknown person")),
+			),
+		)
+
+		// the given path must be copied
+		path[0] = grouperror.Field("animals")
+
+		expected := []string{
+			"validation: people[2]: name: required",
+			"validation: people[2]: unknown person",
+		}
+
+		errs := grouperror.Collection(err)
+		require.Len(t, errs, len(expected))
+		for i, x := range errs {
+			assert.EqualError(t, x, expected[i]) //nolint:testifylint
+		}
+
+		leaves := grouperror.Leaves(err)
+		require.Len(t, leaves, len(expected))
+		assert.Equal(t, "people[2].name", leaves[0].Path.String())
+		assert.Equal(t, "people[2]", leaves[1].Path.String())
+	})
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gontainer/grouperror"
+)
+
+// ContentType is the media type of [Details].
+const ContentType = "application/problem+json"
+
+// Details is a problem details document, see RFC 9457.
+type Details struct {
+	Type     string  `json:"type,omitempty"`
+	Title    string  `json:"title,omitempty"`
+	Status   int     `json:"status,omitempty"`
+	Detail   string  `json:"detail,omitempty"`
+	Instance string  `json:"instance,omitempty"`
+	Errors   []Error `json:"errors,omitempty"`
+}
+
+// Error is a single error listed in [Details].
+type Error struct {
+	// Path is the path of the error, see [grouperror.PrefixPath].
+	// For [*grouperror.FieldError], it includes [grouperror.FieldError.Path], see [grouperror.FieldErrors].
+	Path string `json:"path,omitempty"`
+	// Message is the message of the corresponding element of [grouperror.Collection].
+	Message string `json:"message"`
+	// Code is the machine-readable code of [*grouperror.FieldError].
+	Code string `json:"code,omitempty"`
+	// Params are the parameters of [*grouperror.FieldError].
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// StatusCoder is implemented by errors that determine the HTTP status code, see [Status].
+type StatusCoder interface {
+	StatusCode() int
+}
+
+// Internaler is implemented by errors whose messages must not be exposed to clients, see [Internal].
+type Internaler interface {
+	Internal() bool
+}
+
+/*
+Internal marks the given error as internal. [New] lists internal errors without their messages,
+so details like driver errors or file paths are not exposed to clients.
+Unless the error implements [StatusCoder], its status code is [http.StatusInternalServerError].
+It returns nil, when the given error is nil.
+
+	if err := db.QueryRow(q).Scan(&u); err != nil {
+	    return problem.Internal(err)
+	}
+*/
+func Internal(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &internalError{err: err}
+}
+
+type internalError struct {
+	err error
+}
+
+func (i *internalError) Error() string {
+	return i.err.Error()
+}
+
+func (i *internalError) Unwrap() error {
+	return i.err
+}
+
+// Internal returns true.
+func (i *internalError) Internal() bool {
+	return true
+}
+
+func isInternal(err error) bool {
+	var i Internaler
+
+	return errors.As(err, &i) && i.Internal()
+}
+
+/*
+Status returns the HTTP status code for the given error.
+
+The status code of a single error is determined by [StatusCoder] using [errors.As].
+Errors that do not implement [StatusCoder] default to [http.StatusUnprocessableEntity]
+when they are validation errors, i.e. [*grouperror.FieldError] or errors with a path, see [grouperror.PrefixPath],
+and to [http.StatusInternalServerError] otherwise.
+Therefore, validation errors built with [grouperror.Prefix] only must implement [StatusCoder] to get a 4xx code.
+
+When all the errors from the group have the same status code, Status returns that code.
+Otherwise, it returns [http.StatusInternalServerError] if any of the codes is 5xx,
+and [http.StatusBadRequest] in other cases.
+*/
+func Status(err error) int {
+	status := 0
+
+	for _, l := range grouperror.Leaves(err) {
+		s := leafStatus(l)
+
+		switch {
+		case status == 0 || status == s:
+			status = s
+		case status >= http.StatusInternalServerError || s >= http.StatusInternalServerError:
+			status = http.StatusInternalServerError
+		default:
+			status = http.StatusBadRequest
+		}
+	}
+
+	if status == 0 {
+		return http.StatusInternalServerError
+	}
+
+	return status
+}
+
+func leafStatus(l grouperror.Leaf) int {
+	var sc StatusCoder
+	if errors.As(l.Err, &sc) {
+		return sc.StatusCode()
+	}
+
+	var fe *grouperror.FieldError
+	if !isInternal(l.Err) && (len(l.Path) > 0 || errors.As(l.Err, &fe)) {
+		return http.StatusUnprocessableEntity
+	}
+
+	return http.StatusInternalServerError
+}
+
+/*
+New returns the problem details for the given error. The status is determined by [Status].
+
+Each error from [grouperror.Collection] is listed under the member "errors", regardless of the status.
+Errors of the type [*grouperror.FieldError] are listed with their codes, parameters, and resolved paths.
+Errors marked by [Internal] are listed without their paths, and their messages are replaced by
+the status text, e.g. "Internal Server Error".
+*/
+func New(err error) *Details {
+	status := Status(err)
+	leaves := grouperror.Leaves(err)
+
+	d := &Details{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Errors: make([]Error, 0, len(leaves)),
+	}
+
+	// FieldErrors returns field errors in the same order as Leaves.
+	fieldErrs := grouperror.FieldErrors(err)
+
+	for _, l := range leaves {
+		e := Error{
+			Path:    l.Path.String(),
+			Message: l.Prefix + l.Err.Error(),
+		}
+
+		var fe *grouperror.FieldError
+		if errors.As(l.Err, &fe) {
+			f := fieldErrs[0]
+			fieldErrs = fieldErrs[1:]
+			e.Path = f.Path.String()
+			e.Code = f.Code
+			e.Params = f.Params
+		}
+
+		if isInternal(l.Err) {
+			e = Error{Message: http.StatusText(leafStatus(l))}
+		}
+
+		d.Errors = append(d.Errors, e)
+	}
+
+	return d
+}
+
+// Write writes the given problem details to the response.
+func Write(w http.ResponseWriter, d *Details) error {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(d.Status)
+
+	return json.NewEncoder(w).Encode(d) //nolint:wrapcheck
+}
+
+// HandlerFunc is an HTTP handler that returns an error.
+// Non-nil errors are written to the response as problem details, see [New].
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// ServeHTTP implements [http.Handler].
+func (f HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := f(w, r); err != nil {
+		d := New(err)
+		d.Instance = r.URL.RequestURI()
+		_ = Write(w, d)
+	}
+}
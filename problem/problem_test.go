@@ -0,0 +1,350 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package problem_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/gontainer/grouperror/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type statusError struct {
+	error
+	status int
+}
+
+func (s *statusError) StatusCode() int {
+	return s.status
+}
+
+func newStatusError(msg string, status int) error {
+	return &statusError{
+		error:  errors.New(msg), //nolint:goerr113
+		status: status,
+	}
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+
+	scenarios := map[string]struct {
+		err      error
+		expected int
+	}{
+		"Nil": {
+			err:      nil,
+			expected: http.StatusInternalServerError,
+		},
+		"Without status": {
+			err:      io.EOF,
+			expected: http.StatusInternalServerError,
+		},
+		"Single status": {
+			err:      grouperror.Join(newStatusError("not found", http.StatusNotFound)),
+			expected: http.StatusNotFound,
+		},
+		"Equal statuses": {
+			err: grouperror.Join(
+				newStatusError("invalid name", http.StatusUnprocessableEntity),
+				newStatusError("invalid age", http.StatusUnprocessableEntity),
+			),
+			expected: http.StatusUnprocessableEntity,
+		},
+		"Different 4xx statuses": {
+			err: grouperror.Join(
+				newStatusError("invalid name", http.StatusUnprocessableEntity),
+				newStatusError("not found", http.StatusNotFound),
+			),
+			expected: http.StatusBadRequest,
+		},
+		"Path": {
+			err: grouperror.Prefix(
+				"validation: ",
+				grouperror.PrefixPath(grouperror.Path{grouperror.Field("name")}, errors.New("required")), //nolint:goerr113
+			),
+			expected: http.StatusUnprocessableEntity,
+		},
+		"Field error": {
+			err:      grouperror.Join(&grouperror.FieldError{Code: "required"}),
+			expected: http.StatusUnprocessableEntity,
+		},
+		"Prefix without status": {
+			err:      grouperror.Prefix("validation: ", errors.New("invalid name")), //nolint:goerr113
+			expected: http.StatusInternalServerError,
+		},
+		"4xx and 5xx": {
+			err: grouperror.Join(
+				newStatusError("invalid name", http.StatusUnprocessableEntity),
+				io.EOF,
+			),
+			expected: http.StatusInternalServerError,
+		},
+	}
+
+	for name, s := range scenarios {
+		s := s
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, s.expected, problem.Status(s.err))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	err := grouperror.Prefix(
+		"validation: ",
+		grouperror.PrefixPath(
+			grouperror.Path{grouperror.Field("people"), grouperror.Index(1)},
+			grouperror.PrefixPath(
+				grouperror.Path{grouperror.Field("name")},
+				newStatusError("required", http.StatusUnprocessableEntity),
+			),
+		),
+		newStatusError("invalid token", http.StatusUnprocessableEntity),
+	)
+
+	expected := &problem.Details{
+		Type:   "about:blank",
+		Title:  "Unprocessable Entity",
+		Status: http.StatusUnprocessableEntity,
+		Errors: []problem.Error{
+			{
+				Path:    "people[1].name",
+				Message: "validation: people[1]: name: required",
+			},
+			{
+				Message: "validation: invalid token",
+			},
+		},
+	}
+
+	assert.Equal(t, expected, problem.New(err))
+}
+
+func TestNew_fieldErrors(t *testing.T) {
+	t.Parallel()
+
+	err := grouperror.PrefixPath(
+		grouperror.Path{grouperror.Field("user")},
+		&grouperror.FieldError{
+			Path: grouperror.Path{grouperror.Field("name")},
+			Code: "required",
+		},
+		&grouperror.FieldError{
+			Path:    grouperror.Path{grouperror.Field("bio")},
+			Code:    "too_long",
+			Message: "must be at most 64 characters long",
+			Params:  map[string]interface{}{"max": 64},
+		},
+	)
+
+	b, writeErr := json.Marshal(problem.New(err))
+	require.NoError(t, writeErr)
+	assert.JSONEq(
+		t,
+		`{
+	"type": "about:blank",
+	"title": "Unprocessable Entity",
+	"status": 422,
+	"errors": [
+		{"path": "user.name", "message": "user: name: required", "code": "required"},
+		{
+			"path": "user.bio",
+			"message": "user: bio: must be at most 64 characters long",
+			"code": "too_long",
+			"params": {"max": 64}
+		}
+	]
+}`,
+		string(b),
+	)
+}
+
+//nolint:goerr113
+func TestNew_internalError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Prefix", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Prefix("person: ", grouperror.Prefix("name: ", errors.New("required")))
+
+		expected := &problem.Details{
+			Type:   "about:blank",
+			Title:  "Internal Server Error",
+			Status: http.StatusInternalServerError,
+			Errors: []problem.Error{
+				{Message: "person: name: required"},
+			},
+		}
+
+		assert.Equal(t, expected, problem.New(err))
+	})
+
+	t.Run("Internal", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Prefix(
+			"database: ",
+			newStatusError("invalid name", http.StatusUnprocessableEntity),
+			problem.Internal(errors.New("dial tcp 10.0.0.1:5432: connection refused")),
+		)
+
+		expected := &problem.Details{
+			Type:   "about:blank",
+			Title:  "Internal Server Error",
+			Status: http.StatusInternalServerError,
+			Errors: []problem.Error{
+				{Message: "database: invalid name"},
+				{Message: "Internal Server Error"},
+			},
+		}
+
+		assert.Equal(t, expected, problem.New(err))
+	})
+
+	t.Run("Internal with status", func(t *testing.T) {
+		t.Parallel()
+
+		err := problem.Internal(newStatusError("upstream timeout", http.StatusGatewayTimeout))
+
+		expected := &problem.Details{
+			Type:   "about:blank",
+			Title:  "Gateway Timeout",
+			Status: http.StatusGatewayTimeout,
+			Errors: []problem.Error{
+				{Message: "Gateway Timeout"},
+			},
+		}
+
+		assert.Equal(t, expected, problem.New(err))
+	})
+}
+
+func TestInternal(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, problem.Internal(nil))
+
+	err := problem.Internal(io.EOF)
+	assert.EqualError(t, err, "EOF")
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, http.StatusInternalServerError, problem.Status(err))
+	assert.Equal(
+		t,
+		http.StatusInternalServerError,
+		problem.Status(grouperror.PrefixPath(grouperror.Path{grouperror.Field("name")}, err)),
+	)
+}
+
+func TestHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		h := problem.HandlerFunc(func(http.ResponseWriter, *http.Request) error {
+			return grouperror.Prefix(
+				"validation: ",
+				newStatusError("invalid name", http.StatusUnprocessableEntity),
+				newStatusError("invalid age", http.StatusUnprocessableEntity),
+			)
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/users?dry-run=1", nil))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Equal(t, problem.ContentType, rec.Header().Get("Content-Type"))
+		assert.JSONEq(
+			t,
+			`{
+	"type": "about:blank",
+	"title": "Unprocessable Entity",
+	"status": 422,
+	"instance": "/users?dry-run=1",
+	"errors": [
+		{"message": "validation: invalid name"},
+		{"message": "validation: invalid age"}
+	]
+}`,
+			rec.Body.String(),
+		)
+	})
+
+	t.Run("Internal error", func(t *testing.T) {
+		t.Parallel()
+
+		h := problem.HandlerFunc(func(http.ResponseWriter, *http.Request) error {
+			return grouperror.Prefix(
+				"config: ",
+				problem.Internal(errors.New("open /etc/app/secret.yaml: permission denied")), //nolint:goerr113
+			)
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.JSONEq(
+			t,
+			`{
+	"type": "about:blank",
+	"title": "Internal Server Error",
+	"status": 500,
+	"instance": "/users",
+	"errors": [
+		{"message": "Internal Server Error"}
+	]
+}`,
+			rec.Body.String(),
+		)
+		assert.NotContains(t, rec.Body.String(), "secret.yaml")
+	})
+
+	t.Run("No error", func(t *testing.T) {
+		t.Parallel()
+
+		h := problem.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) error {
+			w.WriteHeader(http.StatusNoContent)
+
+			return nil
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		require.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Empty(t, rec.Body.String())
+	})
+}
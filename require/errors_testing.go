@@ -0,0 +1,87 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package require
+
+import (
+	"github.com/gontainer/grouperror/assert"
+)
+
+// testingT is an interface wrapper around *testing.T.
+type testingT interface {
+	Errorf(format string, args ...any)
+	FailNow()
+	Helper()
+}
+
+// EqualErrorGroup asserts the same as [assert.EqualErrorGroup], and stops the test execution on failure.
+func EqualErrorGroup(t testingT, err error, msgs []string) {
+	t.Helper()
+
+	if assert.EqualErrorGroup(t, err, msgs) {
+		return
+	}
+
+	t.FailNow()
+}
+
+// ElementsMatchErrorGroup asserts the same as [assert.ElementsMatchErrorGroup], and stops the test execution on failure.
+func ElementsMatchErrorGroup(t testingT, err error, msgs []string) {
+	t.Helper()
+
+	if assert.ElementsMatchErrorGroup(t, err, msgs) {
+		return
+	}
+
+	t.FailNow()
+}
+
+// ContainsErrorGroup asserts the same as [assert.ContainsErrorGroup], and stops the test execution on failure.
+func ContainsErrorGroup(t testingT, err error, msgs []string) {
+	t.Helper()
+
+	if assert.ContainsErrorGroup(t, err, msgs) {
+		return
+	}
+
+	t.FailNow()
+}
+
+// RegexpErrorGroup asserts the same as [assert.RegexpErrorGroup], and stops the test execution on failure.
+func RegexpErrorGroup(t testingT, err error, patterns []string) {
+	t.Helper()
+
+	if assert.RegexpErrorGroup(t, err, patterns) {
+		return
+	}
+
+	t.FailNow()
+}
+
+// MatchErrorGroup asserts the same as [assert.MatchErrorGroup], and stops the test execution on failure.
+func MatchErrorGroup(t testingT, err error, matchers ...assert.LeafMatcher) {
+	t.Helper()
+
+	if assert.MatchErrorGroup(t, err, matchers...) {
+		return
+	}
+
+	t.FailNow()
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package require_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	errAssert "github.com/gontainer/grouperror/assert"
+	errRequire "github.com/gontainer/grouperror/require"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockTesting struct {
+	output string
+	failed bool
+}
+
+func (m *mockTesting) Errorf(format string, args ...any) {
+	m.output += fmt.Sprintf(format, args...)
+}
+
+func (m *mockTesting) FailNow() {
+	m.failed = true
+}
+
+func (m *mockTesting) Helper() {}
+
+func TestRequire(t *testing.T) {
+	t.Parallel()
+
+	err := grouperror.Join(os.ErrClosed, os.ErrExist)
+
+	scenarios := map[string]struct {
+		assertion func(t *mockTesting)
+		failed    bool
+	}{
+		"EqualErrorGroup [OK]": {
+			assertion: func(t *mockTesting) {
+				errRequire.EqualErrorGroup(t, err, []string{"file already closed", "file already exists"})
+			},
+			failed: false,
+		},
+		"EqualErrorGroup [error]": {
+			assertion: func(t *mockTesting) {
+				errRequire.EqualErrorGroup(t, err, []string{"file already exists", "file already closed"})
+			},
+			failed: true,
+		},
+		"EqualErrorGroup [error, nil]": {
+			assertion: func(t *mockTesting) {
+				errRequire.EqualErrorGroup(t, err, nil)
+			},
+			failed: true,
+		},
+		"ElementsMatchErrorGroup [OK]": {
+			assertion: func(t *mockTesting) {
+				errRequire.ElementsMatchErrorGroup(t, err, []string{"file already exists", "file already closed"})
+			},
+			failed: false,
+		},
+		"ElementsMatchErrorGroup [error]": {
+			assertion: func(t *mockTesting) {
+				errRequire.ElementsMatchErrorGroup(t, err, []string{"file already exists"})
+			},
+			failed: true,
+		},
+		"ContainsErrorGroup [OK]": {
+			assertion: func(t *mockTesting) {
+				errRequire.ContainsErrorGroup(t, err, []string{"file already exists"})
+			},
+			failed: false,
+		},
+		"ContainsErrorGroup [error]": {
+			assertion: func(t *mockTesting) {
+				errRequire.ContainsErrorGroup(t, err, []string{"invalid argument"})
+			},
+			failed: true,
+		},
+		"MatchErrorGroup [OK]": {
+			assertion: func(t *mockTesting) {
+				errRequire.MatchErrorGroup(t, err, errAssert.LeafIs(os.ErrClosed), errAssert.LeafIs(os.ErrExist))
+			},
+			failed: false,
+		},
+		"MatchErrorGroup [error]": {
+			assertion: func(t *mockTesting) {
+				errRequire.MatchErrorGroup(t, err, errAssert.LeafIs(os.ErrExist))
+			},
+			failed: true,
+		},
+		"RegexpErrorGroup [OK]": {
+			assertion: func(t *mockTesting) {
+				errRequire.RegexpErrorGroup(t, err, []string{"closed$", "exists$"})
+			},
+			failed: false,
+		},
+		"RegexpErrorGroup [error]": {
+			assertion: func(t *mockTesting) {
+				errRequire.RegexpErrorGroup(t, err, []string{"exists$", "closed$"})
+			},
+			failed: true,
+		},
+	}
+
+	for name, s := range scenarios {
+		s := s
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mt := new(mockTesting)
+			s.assertion(mt)
+			assert.Equal(t, s.failed, mt.failed)
+
+			if s.failed {
+				assert.NotEmpty(t, mt.output)
+			} else {
+				assert.Empty(t, mt.output)
+			}
+		})
+	}
+}
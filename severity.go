@@ -0,0 +1,137 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"errors"
+)
+
+// Severity is the severity of an error.
+type Severity int
+
+const (
+	// SeverityError is the default severity.
+	SeverityError Severity = iota
+	// SeverityWarning is the severity of errors created by [Warning].
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+
+	return "error"
+}
+
+/*
+Warning marks the given error as a warning. When the given error is a group, it marks all the errors in the group.
+It returns nil, when the given error is nil.
+Warnings are still errors, they can be joined with other errors, and their messages remain unchanged.
+
+	err := grouperror.Join(
+	    grouperror.Warning(errors.New("deprecated option")),
+	    errors.New("invalid port"),
+	)
+	errs, warnings := grouperror.SplitWarnings(err)
+
+See [SplitWarnings].
+See [Err].
+*/
+func Warning(err error) error {
+	return Map(err, func(err error) error {
+		if SeverityOf(err) == SeverityWarning {
+			return err
+		}
+
+		return &warningError{err: err}
+	})
+}
+
+// severer is implemented by errors that determine their own severity, see [SeverityOf].
+type severer interface {
+	Severity() Severity
+}
+
+type warningError struct {
+	err error
+}
+
+func (w *warningError) Error() string {
+	return w.err.Error()
+}
+
+func (w *warningError) Unwrap() error {
+	return w.err
+}
+
+// Severity returns [SeverityWarning].
+func (w *warningError) Severity() Severity {
+	return SeverityWarning
+}
+
+// SeverityOf returns [SeverityWarning] when the given group contains at least one error, and all its errors are warnings.
+// Otherwise, it returns [SeverityError], e.g. for a custom group whose Collection() returns only nils.
+// The severity of a single error is determined by the first error in its chain that implements
+// `interface{ Severity() Severity }`, see [errors.As].
+func SeverityOf(err error) Severity {
+	if err == nil {
+		return SeverityError
+	}
+
+	warning := false
+
+	eachLeaf(err, "", nil, func(l Leaf) bool {
+		var s severer
+		warning = errors.As(l.Err, &s) && s.Severity() == SeverityWarning
+
+		return warning
+	})
+
+	if warning {
+		return SeverityWarning
+	}
+
+	return SeverityError
+}
+
+// SplitWarnings splits the given group into errors and warnings preserving the structure of the group.
+// It returns nil instead of an empty group.
+func SplitWarnings(err error) (errs error, warnings error) { //nolint:nonamedreturns
+	warnings, errs = Partition(err, func(err error) bool {
+		return SeverityOf(err) == SeverityWarning
+	})
+
+	return errs, warnings
+}
+
+// Err returns nil, when the given error consists of warnings only. Otherwise, it returns the given error.
+//
+//	if err := grouperror.Err(loadConfig()); err != nil {
+//	    return err
+//	}
+func Err(err error) error {
+	if SeverityOf(err) == SeverityWarning {
+		return nil
+	}
+
+	return err
+}
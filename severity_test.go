@@ -0,0 +1,195 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeverity_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "error", grouperror.SeverityError.String())
+	assert.Equal(t, "warning", grouperror.SeverityWarning.String())
+}
+
+//nolint:goerr113
+func TestWarning(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, grouperror.Warning(nil))
+	})
+
+	t.Run("Single error", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Warning(io.EOF)
+		assert.EqualError(t, err, "EOF")
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, grouperror.SeverityWarning, grouperror.SeverityOf(err))
+		assert.Equal(t, grouperror.SeverityWarning, grouperror.SeverityOf(fmt.Errorf("wrapped: %w", err)))
+		assert.Equal(t, grouperror.SeverityError, grouperror.SeverityOf(io.EOF))
+		assert.Equal(t, grouperror.SeverityError, grouperror.SeverityOf(nil))
+
+		var s interface{ Severity() grouperror.Severity }
+		require.ErrorAs(t, err, &s)
+		assert.Equal(t, grouperror.SeverityWarning, s.Severity())
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Warning(grouperror.Prefix(
+			"my group: ",
+			errors.New("error1"),
+			grouperror.Warning(errors.New("error2")),
+		))
+
+		assert.EqualError(t, err, "my group: error1\nmy group: error2")
+		assert.Equal(t, grouperror.SeverityWarning, grouperror.SeverityOf(err))
+
+		for _, l := range grouperror.Leaves(err) {
+			assert.Equal(t, grouperror.SeverityWarning, grouperror.SeverityOf(l.Err))
+			assert.Nil(t, errors.Unwrap(errors.Unwrap(l.Err)), "warnings must not be wrapped twice")
+		}
+	})
+}
+
+//nolint:goerr113
+func TestSplitWarnings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		errs, warnings := grouperror.SplitWarnings(nil)
+		require.NoError(t, errs)
+		require.NoError(t, warnings)
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Prefix(
+			"config: ",
+			grouperror.Prefix(
+				"server: ",
+				grouperror.Warning(errors.New("deprecated option `ssl`")),
+				errors.New("invalid port"),
+			),
+			grouperror.Prefix("database: ", grouperror.Warning(errors.New("password is empty"))),
+			errors.New("unknown option `foo`"),
+		)
+
+		errs, warnings := grouperror.SplitWarnings(err)
+		assertMessages(t, errs, []string{
+			"config: server: invalid port",
+			"config: unknown option `foo`",
+		})
+		assertMessages(t, warnings, []string{
+			"config: server: deprecated option `ssl`",
+			"config: database: password is empty",
+		})
+
+		assert.Equal(
+			t,
+			`group "config: "
+├── group "server: "
+│   └── deprecated option `+"`ssl`"+`
+└── group "database: "
+    └── password is empty`,
+			fmt.Sprintf("%+v", warnings),
+		)
+	})
+
+	t.Run("Warnings only", func(t *testing.T) {
+		t.Parallel()
+
+		errs, warnings := grouperror.SplitWarnings(grouperror.Warning(grouperror.Join(io.EOF, io.ErrUnexpectedEOF)))
+		require.NoError(t, errs)
+		assertMessages(t, warnings, []string{"EOF", "unexpected EOF"})
+	})
+}
+
+// nilCollection is a non-nil error, that does not contain any errors.
+type nilCollection struct{}
+
+func (nilCollection) Error() string {
+	return "nil collection"
+}
+
+func (nilCollection) Collection() []error {
+	return []error{nil, nil}
+}
+
+func TestErr(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, grouperror.Err(nil))
+	require.NoError(t, grouperror.Err(grouperror.Warning(io.EOF)))
+	require.NoError(t, grouperror.Err(grouperror.Join(grouperror.Warning(io.EOF), grouperror.Warning(io.ErrClosedPipe))))
+
+	err := grouperror.Join(grouperror.Warning(io.EOF), io.ErrClosedPipe)
+	assert.Same(t, err, grouperror.Err(err))
+
+	t.Run("Empty collection", func(t *testing.T) {
+		t.Parallel()
+
+		var err error = nilCollection{}
+		assert.Equal(t, grouperror.SeverityError, grouperror.SeverityOf(err))
+		assert.Equal(t, err, grouperror.Err(err))
+	})
+}
+
+func TestSeverityOf_dedup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Warning and error", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Dedup(grouperror.Join(grouperror.Warning(io.EOF), io.EOF))
+		assert.EqualError(t, err, "EOF (x2)")
+		assert.Equal(t, grouperror.SeverityError, grouperror.SeverityOf(err))
+		assert.Same(t, err, grouperror.Err(err))
+
+		errs, warnings := grouperror.SplitWarnings(err)
+		assert.EqualError(t, errs, "EOF (x2)")
+		require.NoError(t, warnings)
+	})
+
+	t.Run("Warnings only", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Dedup(grouperror.Join(grouperror.Warning(io.EOF), grouperror.Warning(io.EOF)))
+		assert.Equal(t, grouperror.SeverityWarning, grouperror.SeverityOf(err))
+		require.NoError(t, grouperror.Err(err))
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.21
+// +build go1.21
+
+package grouperror
+
+import (
+	"context"
+	"log/slog"
+)
+
+// logEntry is a single error logged by [slog].
+type logEntry struct {
+	Prefix  string `json:"prefix"`
+	Path    string `json:"path,omitempty"`
+	Message string `json:"message"`
+}
+
+func logValue(err error) slog.Value {
+	var (
+		entries []logEntry
+		omitted int
+	)
+
+	eachVisible(err, "", nil, func(l Leaf, hidden int) {
+		if hidden > 0 {
+			omitted += hidden
+
+			return
+		}
+
+		entries = append(entries, logEntry{
+			Prefix:  l.Prefix,
+			Path:    l.Path.String(),
+			Message: l.Err.Error(),
+		})
+	})
+
+	if entries == nil {
+		entries = []logEntry{}
+	}
+
+	attrs := []slog.Attr{
+		slog.Int("count", len(entries)+omitted),
+		slog.Any("errors", entries),
+	}
+
+	if omitted > 0 {
+		attrs = append(attrs, slog.Int("omitted", omitted))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+/*
+LogValue implements [slog.LogValuer]. The group is logged as a group attribute
+with the number of errors, and the list of errors with their prefixes:
+
+	{"err":{"count":2,"errors":[{"prefix":"my group: ","message":"error1"},{"prefix":"my group: ","message":"error2"}]}}
+
+Errors hidden by [Limit] are not listed, their number is logged under the key "omitted".
+*/
+func (g *groupError) LogValue() slog.Value {
+	return logValue(g)
+}
+
+// NewSlogHandler returns a [slog.Handler] that logs groups of errors found in attributes the same way as
+// [slog.LogValuer] implemented by this package, including groups that do not implement [slog.LogValuer]
+// themselves, e.g. custom errors that implement `interface{ Collection() []error }`.
+func NewSlogHandler(next slog.Handler) slog.Handler {
+	return &slogHandler{next: next}
+}
+
+type slogHandler struct {
+	next slog.Handler
+}
+
+func (h *slogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *slogHandler) Handle(ctx context.Context, r slog.Record) error {
+	expanded := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+
+	r.Attrs(func(a slog.Attr) bool {
+		expanded.AddAttrs(expandAttr(a))
+
+		return true
+	})
+
+	return h.next.Handle(ctx, expanded) //nolint:wrapcheck
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	expanded := make([]slog.Attr, 0, len(attrs))
+	for _, a := range attrs {
+		expanded = append(expanded, expandAttr(a))
+	}
+
+	return &slogHandler{next: h.next.WithAttrs(expanded)}
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	return &slogHandler{next: h.next.WithGroup(name)}
+}
+
+func expandAttr(a slog.Attr) slog.Attr {
+	switch a.Value.Kind() { //nolint:exhaustive
+	case slog.KindAny:
+		if err, ok := a.Value.Any().(error); ok {
+			if _, ok := groupOf(err); ok {
+				return slog.Attr{Key: a.Key, Value: logValue(err)}
+			}
+		}
+	case slog.KindGroup:
+		attrs := a.Value.Group()
+		expanded := make([]slog.Attr, 0, len(attrs))
+
+		for _, x := range attrs {
+			expanded = append(expanded, expandAttr(x))
+		}
+
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(expanded...)}
+	}
+
+	return a
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.21
+// +build go1.21
+
+package grouperror_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLogger(buf *bytes.Buffer, wrap bool) *slog.Logger {
+	var h slog.Handler = slog.NewJSONHandler(buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+
+			return a
+		},
+	})
+
+	if wrap {
+		h = grouperror.NewSlogHandler(h)
+	}
+
+	return slog.New(h)
+}
+
+//nolint:goerr113
+func TestGroupError_LogValue(t *testing.T) {
+	t.Parallel()
+
+	err := grouperror.Prefix(
+		"my group: ",
+		errors.New("error1"),
+		grouperror.PrefixPath(grouperror.Path{grouperror.Field("name")}, errors.New("required")),
+	)
+
+	buf := bytes.NewBuffer(nil)
+	newTestLogger(buf, false).Error("failure", slog.Any("err", err))
+
+	assert.JSONEq(
+		t,
+		`{
+	"level": "ERROR",
+	"msg": "failure",
+	"err": {
+		"count": 2,
+		"errors": [
+			{"prefix": "my group: ", "message": "error1"},
+			{"prefix": "my group: name: ", "path": "name", "message": "required"}
+		]
+	}
+}`,
+		buf.String(),
+	)
+}
+
+func TestNewSlogHandler(t *testing.T) {
+	t.Parallel()
+
+	custom := &wrappedError{error: grouperror.Prefix("wrapped: ", io.EOF, io.ErrUnexpectedEOF)}
+	expectedCustom := `{
+	"count": 2,
+	"errors": [
+		{"prefix": "wrapped: ", "message": "EOF"},
+		{"prefix": "wrapped: ", "message": "unexpected EOF"}
+	]
+}`
+
+	t.Run("Without handler", func(t *testing.T) {
+		t.Parallel()
+
+		buf := bytes.NewBuffer(nil)
+		newTestLogger(buf, false).Info("failure", slog.Any("err", custom))
+
+		assert.JSONEq(
+			t,
+			`{"level":"INFO","msg":"failure","err":"wrapped: EOF\nwrapped: unexpected EOF"}`,
+			buf.String(),
+		)
+	})
+
+	t.Run("With handler", func(t *testing.T) {
+		t.Parallel()
+
+		buf := bytes.NewBuffer(nil)
+		logger := newTestLogger(buf, true).
+			With(slog.Any("attr", custom)).
+			WithGroup("details")
+
+		logger.Info(
+			"failure",
+			slog.Any("err", custom),
+			slog.Group("nested", slog.Any("err", custom), slog.Int("code", 5)),
+			slog.Any("single", io.EOF),
+		)
+
+		assert.JSONEq(
+			t,
+			`{
+	"level": "INFO",
+	"msg": "failure",
+	"attr": `+expectedCustom+`,
+	"details": {
+		"err": `+expectedCustom+`,
+		"nested": {"err": `+expectedCustom+`, "code": 5},
+		"single": "EOF"
+	}
+}`,
+			buf.String(),
+		)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		t.Parallel()
+
+		buf := bytes.NewBuffer(nil)
+		newTestLogger(buf, true).Debug("debug")
+		assert.Empty(t, buf.String())
+	})
+}
+
+func TestGroupError_LogValue_limit(t *testing.T) {
+	t.Parallel()
+
+	err := grouperror.Prefix(
+		"import: ",
+		grouperror.Limit(grouperror.Join(io.EOF, io.ErrUnexpectedEOF, io.ErrClosedPipe), 1),
+	)
+
+	buf := bytes.NewBuffer(nil)
+	newTestLogger(buf, false).Error("failure", slog.Any("err", err))
+
+	assert.JSONEq(
+		t,
+		`{
+	"level": "ERROR",
+	"msg": "failure",
+	"err": {
+		"count": 3,
+		"errors": [
+			{"prefix": "import: ", "message": "EOF"}
+		],
+		"omitted": 2
+	}
+}`,
+		buf.String(),
+	)
+}
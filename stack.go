@@ -0,0 +1,187 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+const maxStackDepth = 32
+
+// stack is a captured call stack.
+type stack []uintptr
+
+// callers captures the call stack of the caller of the exported function that calls callers.
+func callers() stack {
+	pcs := make([]uintptr, maxStackDepth)
+	// skip runtime.Callers, callers, and the exported function
+	n := runtime.Callers(3, pcs)
+
+	return pcs[:n]
+}
+
+func (s stack) frames() []runtime.Frame {
+	if len(s) == 0 {
+		return nil
+	}
+
+	frames := runtime.CallersFrames(s)
+	result := make([]runtime.Frame, 0, len(s))
+
+	for {
+		f, more := frames.Next()
+		result = append(result, f)
+
+		if !more {
+			break
+		}
+	}
+
+	return result
+}
+
+func (s stack) lines() []string {
+	frames := s.frames()
+	lines := make([]string, 0, len(frames))
+
+	for _, f := range frames {
+		lines = append(lines, "at "+f.Function+" ("+f.File+":"+strconv.Itoa(f.Line)+")")
+	}
+
+	return lines
+}
+
+// JoinWithStack joins errors the same way as [Join], and captures the call stack.
+func JoinWithStack(errs ...error) error {
+	filtered := filterNil(errs)
+	if filtered == nil {
+		return nil
+	}
+
+	return &groupError{
+		errors: filtered,
+		stack:  callers(),
+	}
+}
+
+/*
+PrefixWithStack joins errors the same way as [Prefix], and captures the call stack.
+The stack is available through the method `StackTrace() []runtime.Frame`, and it is printed by the verb %+v.
+
+	err := grouperror.PrefixWithStack("my group: ", errors.New("error1"), errors.New("error2"))
+	fmt.Printf("%+v\n", err)
+	// Output:
+	// group "my group: "
+	// │   at main.main (/app/main.go:10)
+	// │   at runtime.main (/usr/local/go/src/runtime/proc.go:250)
+	// ├── error1
+	// └── error2
+
+Capturing the stack is expensive, use [Prefix] whenever you do not need it.
+*/
+func PrefixWithStack(prefix string, errs ...error) error {
+	filtered := filterNil(errs)
+	if filtered == nil {
+		return nil
+	}
+
+	return &groupError{
+		prefix: prefix,
+		errors: filtered,
+		stack:  callers(),
+	}
+}
+
+// StackTrace returns the call stack captured by [JoinWithStack] or [PrefixWithStack].
+// It returns nil, when the stack has not been captured.
+func (g *groupError) StackTrace() []runtime.Frame {
+	return g.stack.frames()
+}
+
+// WithStack captures the call stack and attaches it to the given error.
+// The stack is available through the method `StackTrace() []runtime.Frame`, and it is printed by the verb %+v.
+// It returns nil, when the given error is nil.
+//
+// When the given error is a group created by this package, WithStack returns a copy of the group with the call stack.
+func WithStack(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if g, ok := err.(*groupError); ok { //nolint:errorlint
+		return &groupError{
+			prefix: g.prefix,
+			path:   g.path,
+			errors: g.errors,
+			stack:  callers(),
+		}
+	}
+
+	return &stackError{
+		err:   err,
+		stack: callers(),
+	}
+}
+
+type stackError struct {
+	err   error
+	stack stack
+}
+
+func (s *stackError) Error() string {
+	return s.err.Error()
+}
+
+func (s *stackError) Unwrap() error {
+	return s.err
+}
+
+// StackTrace returns the call stack captured by [WithStack].
+func (s *stackError) StackTrace() []runtime.Frame {
+	return s.stack.frames()
+}
+
+// Format implements [fmt.Formatter], the verb %+v prints the message and the call stack.
+func (s *stackError) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('+') {
+		lines := []string{fmt.Sprintf("%+v", s.err)}
+		for _, l := range s.stack.lines() {
+			lines = append(lines, "    "+l)
+		}
+
+		_, _ = io.WriteString(f, strings.Join(lines, "\n"))
+
+		return
+	}
+
+	switch verb {
+	case 'v', 's':
+		_, _ = io.WriteString(f, s.Error())
+	case 'q':
+		_, _ = fmt.Fprintf(f, "%q", s.Error())
+	default:
+		_, _ = fmt.Fprintf(f, "%%!%c(%T=%s)", verb, s, s.Error())
+	}
+}
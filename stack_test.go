@@ -0,0 +1,160 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stackTracer interface {
+	StackTrace() []runtime.Frame
+}
+
+func newGroupWithStack() error {
+	return grouperror.PrefixWithStack("my group: ", io.EOF, nil, io.ErrUnexpectedEOF)
+}
+
+func newJoinWithStack() error {
+	return grouperror.JoinWithStack(io.EOF)
+}
+
+func newErrorWithStack(err error) error {
+	return grouperror.WithStack(err)
+}
+
+func TestPrefixWithStack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, grouperror.PrefixWithStack("my group: ", nil))
+		require.NoError(t, grouperror.JoinWithStack(nil))
+		require.NoError(t, grouperror.WithStack(nil))
+	})
+
+	t.Run("StackTrace", func(t *testing.T) {
+		t.Parallel()
+
+		scenarios := map[string]struct {
+			err      error
+			function string
+		}{
+			"PrefixWithStack": {
+				err:      newGroupWithStack(),
+				function: "github.com/gontainer/grouperror_test.newGroupWithStack",
+			},
+			"JoinWithStack": {
+				err:      newJoinWithStack(),
+				function: "github.com/gontainer/grouperror_test.newJoinWithStack",
+			},
+			"WithStack": {
+				err:      newErrorWithStack(io.EOF),
+				function: "github.com/gontainer/grouperror_test.newErrorWithStack",
+			},
+			"WithStack(group)": {
+				err:      newErrorWithStack(grouperror.Prefix("my group: ", io.EOF)),
+				function: "github.com/gontainer/grouperror_test.newErrorWithStack",
+			},
+		}
+
+		for name, s := range scenarios {
+			s := s
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				var st stackTracer
+				require.ErrorAs(t, s.err, &st)
+
+				frames := st.StackTrace()
+				require.NotEmpty(t, frames)
+				assert.Equal(t, s.function, frames[0].Function)
+				assert.True(t, strings.HasSuffix(frames[0].File, "stack_test.go"))
+			})
+		}
+	})
+
+	t.Run("No stack", func(t *testing.T) {
+		t.Parallel()
+
+		var st stackTracer
+		require.ErrorAs(t, grouperror.Join(io.EOF), &st)
+		assert.Nil(t, st.StackTrace())
+	})
+
+	t.Run("Messages", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Prefix("errors: ", newGroupWithStack(), newErrorWithStack(io.ErrClosedPipe))
+		assert.EqualError(
+			t,
+			err,
+			"errors: my group: EOF\nerrors: my group: unexpected EOF\nerrors: io: read/write on closed pipe",
+		)
+		assert.ErrorIs(t, err, io.ErrClosedPipe)
+		assert.Equal(t, "io: read/write on closed pipe", fmt.Sprintf("%v", newErrorWithStack(io.ErrClosedPipe)))
+	})
+
+	//nolint:goerr113
+	t.Run("Format", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Prefix(
+			"errors: ",
+			newGroupWithStack(),
+			newErrorWithStack(errors.New("my error")),
+		)
+
+		lines := strings.Split(fmt.Sprintf("%+v", err), "\n")
+		require.Greater(t, len(lines), 6)
+
+		assert.Equal(t, `group "errors: "`, lines[0])
+		assert.Equal(t, `├── group "my group: "`, lines[1])
+		assert.True(t, strings.HasPrefix(lines[2], "│   │   at github.com/gontainer/grouperror_test.newGroupWithStack ("))
+		assert.Contains(t, lines, "│   ├── EOF")
+		assert.Contains(t, lines, "│   └── unexpected EOF")
+		assert.Contains(t, lines, "└── my error")
+
+		i := indexOf(lines, "└── my error")
+		require.Less(t, i+1, len(lines))
+		assert.True(t, strings.HasPrefix(lines[i+1], "        at github.com/gontainer/grouperror_test.newErrorWithStack ("))
+	})
+}
+
+func indexOf(lines []string, s string) int {
+	for i, l := range lines {
+		if l == s {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"errors"
+)
+
+// withErrors returns a copy of the group with the given errors. It returns nil, when there are no errors.
+func (g *groupError) withErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &groupError{
+		prefix: g.prefix,
+		path:   g.path,
+		errors: errs,
+		stack:  g.stack,
+	}
+}
+
+/*
+Map applies the given function to each single error of the given group,
+and returns a group with identical prefixes and nesting, see [Prefix].
+The function receives the original errors, without prefixes, see [Leaf].
+Errors mapped to nil are removed, and so are the groups that become empty.
+It returns nil, when no errors remain.
+
+	err = grouperror.Map(err, func(err error) error {
+	    if errors.Is(err, sql.ErrNoRows) {
+	        return ErrNotFound
+	    }
+	    return err
+	})
+*/
+func Map(err error, fn func(error) error) error {
+	if err == nil {
+		return nil
+	}
+
+	if l, ok := err.(*limitError); ok { //nolint:errorlint
+		return Limit(Map(l.err, fn), l.n)
+	}
+
+	g, ok := groupOf(err)
+	if !ok {
+		return fn(err)
+	}
+
+	errs := make([]error, 0, len(g.errors))
+
+	for _, x := range g.errors {
+		if y := Map(x, fn); y != nil {
+			errs = append(errs, y)
+		}
+	}
+
+	return g.withErrors(errs)
+}
+
+/*
+Filter returns the errors from the given group that satisfy the given predicate.
+Contrary to [Collection], it preserves the structure built by nested calls of [Prefix].
+The predicate receives the original errors, without prefixes, see [Leaf].
+It returns nil, when no errors remain.
+
+	err = grouperror.Filter(err, func(err error) bool {
+	    return !errors.Is(err, context.Canceled)
+	})
+*/
+func Filter(err error, pred func(error) bool) error {
+	return Map(err, func(err error) error {
+		if pred(err) {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// Partition splits the given group into errors that satisfy the given predicate, and the remaining ones.
+// It preserves the structure of the group, see [Filter]. The predicate is called once per error.
+func Partition(err error, pred func(error) bool) (matched error, rest error) { //nolint:nonamedreturns
+	if err == nil {
+		return nil, nil
+	}
+
+	if l, ok := err.(*limitError); ok { //nolint:errorlint
+		matched, rest = Partition(l.err, pred)
+
+		return Limit(matched, l.n), Limit(rest, l.n)
+	}
+
+	g, ok := groupOf(err)
+	if !ok {
+		if pred(err) {
+			return err, nil
+		}
+
+		return nil, err
+	}
+
+	var matchedErrs, restErrs []error
+
+	for _, x := range g.errors {
+		m, r := Partition(x, pred)
+
+		if m != nil {
+			matchedErrs = append(matchedErrs, m)
+		}
+
+		if r != nil {
+			restErrs = append(restErrs, r)
+		}
+	}
+
+	return g.withErrors(matchedErrs), g.withErrors(restErrs)
+}
+
+// Without removes the errors that match any of the given targets from the given group, see [errors.Is].
+// It preserves the structure of the group, see [Filter].
+func Without(err error, targets ...error) error {
+	return Filter(err, func(err error) bool {
+		for _, t := range targets {
+			if errors.Is(err, t) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func isCanceled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+//nolint:goerr113
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	group := grouperror.Prefix(
+		"tasks: ",
+		grouperror.Prefix(
+			"task #1: ",
+			context.Canceled,
+			errors.New("connection refused"),
+		),
+		grouperror.Prefix("task #2: ", context.Canceled),
+		&wrappedError{error: grouperror.Join(io.EOF, context.DeadlineExceeded)},
+		fmt.Errorf("task #3: %w", context.Canceled),
+	)
+
+	errNotFound := errors.New("not found")
+
+	translate := func(err error) error {
+		switch {
+		case errors.Is(err, io.EOF):
+			return errNotFound
+		case isCanceled(err):
+			return nil
+		}
+
+		return err
+	}
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, grouperror.Map(nil, translate))
+	})
+
+	t.Run("Single error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Same(t, errNotFound, grouperror.Map(io.EOF, translate))
+		require.NoError(t, grouperror.Map(context.Canceled, translate))
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Map(group, translate)
+		assert.Equal(
+			t,
+			`group "tasks: "
+├── group "task #1: "
+│   └── connection refused
+└── group ""
+    └── group ""
+        ├── not found
+        └── context deadline exceeded`,
+			fmt.Sprintf("%+v", err),
+		)
+		assert.ErrorIs(t, err, errNotFound)
+		assert.NotErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("Path", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.PrefixPath(
+			grouperror.Path{grouperror.Field("people"), grouperror.Index(2)},
+			grouperror.PrefixPath(grouperror.Path{grouperror.Field("name")}, io.EOF),
+		)
+		leaves := grouperror.Leaves(grouperror.Map(err, translate))
+		require.Len(t, leaves, 1)
+		assert.Equal(t, "people[2].name", leaves[0].Path.String())
+		assert.Same(t, errNotFound, leaves[0].Err)
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Map(grouperror.Limit(group, 1), translate)
+		assert.EqualError(t, err, "tasks: task #1: connection refused\n... and 2 more errors")
+	})
+}
+
+//nolint:goerr113
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	group := grouperror.Prefix(
+		"tasks: ",
+		grouperror.Prefix(
+			"task #1: ",
+			context.Canceled,
+			errors.New("connection refused"),
+		),
+		grouperror.Prefix("task #2: ", context.Canceled),
+		&wrappedError{error: grouperror.Join(io.EOF, context.DeadlineExceeded)},
+		fmt.Errorf("task #3: %w", context.Canceled),
+	)
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, grouperror.Filter(nil, isCanceled))
+	})
+
+	t.Run("Single error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Same(t, context.Canceled, grouperror.Filter(context.Canceled, isCanceled))
+		require.NoError(t, grouperror.Filter(io.EOF, isCanceled))
+	})
+
+	t.Run("Nothing remains", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, grouperror.Filter(group, func(error) bool {
+			return false
+		}))
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Filter(group, isCanceled)
+		assert.Equal(
+			t,
+			`group "tasks: "
+├── group "task #1: "
+│   └── context canceled
+├── group "task #2: "
+│   └── context canceled
+└── task #3: context canceled`,
+			fmt.Sprintf("%+v", err),
+		)
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Filter(grouperror.Limit(group, 1), isCanceled)
+		assert.EqualError(t, err, "tasks: task #1: context canceled\n... and 2 more errors")
+	})
+}
+
+//nolint:goerr113
+func TestPartition(t *testing.T) {
+	t.Parallel()
+
+	group := grouperror.Prefix(
+		"tasks: ",
+		grouperror.Prefix(
+			"task #1: ",
+			context.Canceled,
+			errors.New("connection refused"),
+		),
+		grouperror.Prefix("task #2: ", context.Canceled),
+		&wrappedError{error: grouperror.Join(io.EOF, context.DeadlineExceeded)},
+		fmt.Errorf("task #3: %w", context.Canceled),
+	)
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		matched, rest := grouperror.Partition(nil, isCanceled)
+		require.NoError(t, matched)
+		require.NoError(t, rest)
+	})
+
+	t.Run("Single error", func(t *testing.T) {
+		t.Parallel()
+
+		matched, rest := grouperror.Partition(io.EOF, isCanceled)
+		require.NoError(t, matched)
+		assert.Same(t, io.EOF, rest)
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		matched, rest := grouperror.Partition(group, func(err error) bool {
+			calls++
+
+			return isCanceled(err)
+		})
+
+		assert.Equal(t, 6, calls)
+		assertMessages(t, matched, []string{
+			"tasks: task #1: context canceled",
+			"tasks: task #2: context canceled",
+			"tasks: task #3: context canceled",
+		})
+		assert.Equal(
+			t,
+			`group "tasks: "
+├── group "task #1: "
+│   └── connection refused
+└── group ""
+    └── group ""
+        ├── EOF
+        └── context deadline exceeded`,
+			fmt.Sprintf("%+v", rest),
+		)
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		t.Parallel()
+
+		matched, rest := grouperror.Partition(grouperror.Limit(group, 2), isCanceled)
+		assert.EqualError(t, matched, "tasks: task #1: context canceled\ntasks: task #2: context canceled\n... and 1 more error")
+		assert.EqualError(t, rest, "tasks: task #1: connection refused\ntasks: EOF\n... and 1 more error")
+	})
+}
+
+//nolint:goerr113
+func TestWithout(t *testing.T) {
+	t.Parallel()
+
+	group := grouperror.Prefix(
+		"tasks: ",
+		grouperror.Prefix(
+			"task #1: ",
+			context.Canceled,
+			errors.New("connection refused"),
+		),
+		grouperror.Prefix("task #2: ", context.Canceled),
+		&wrappedError{error: grouperror.Join(io.EOF, context.DeadlineExceeded)},
+		fmt.Errorf("task #3: %w", context.Canceled),
+	)
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, grouperror.Without(nil, context.Canceled))
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		t.Parallel()
+
+		err := grouperror.Without(group, context.Canceled, io.EOF)
+		assertMessages(t, err, []string{
+			"tasks: task #1: connection refused",
+			"tasks: context deadline exceeded",
+		})
+	})
+
+	t.Run("Nothing remains", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, grouperror.Without(grouperror.Join(context.Canceled, io.EOF), context.Canceled, io.EOF))
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror
+
+import (
+	"errors"
+)
+
+// Node describes a group or a single error visited by [Walk].
+type Node struct {
+	// Depth is the depth of the node in the tree, the root has depth 0.
+	Depth int
+	// Prefix is the prefix of the group, it is empty for single errors.
+	Prefix string
+	// FullPrefix is the accumulated prefix of all the enclosing groups and the node itself.
+	FullPrefix string
+	// Path is the accumulated path of all the enclosing groups and the node itself, see [PrefixPath].
+	Path Path
+	// Group reports whether the node is a group.
+	Group bool
+	// Err is the group, or the original error without any prefix.
+	Err error
+}
+
+//nolint:errname,revive,stylecheck
+var (
+	// SkipGroup is used as a return value from the function passed to [Walk] to indicate
+	// that the children of the current group are to be skipped.
+	// When it is returned for a single error, Walk skips the remaining errors in the enclosing group.
+	SkipGroup = errors.New("skip this group")
+
+	// SkipAll is used as a return value from the function passed to [Walk] to indicate
+	// that all the remaining nodes are to be skipped.
+	SkipAll = errors.New("skip everything")
+)
+
+/*
+Walk walks the tree of the given error in the depth-first order, calling fn for each group and each single error.
+Contrary to [Collection], it preserves the structure built by nested calls of [Prefix].
+
+If fn returns [SkipGroup] or [SkipAll], Walk skips the corresponding nodes.
+If fn returns another non-nil error, Walk stops and returns that error.
+
+	err := grouperror.Prefix("validation: ", errors.New("invalid name"), grouperror.Prefix("address: ", errors.New("invalid zip code")))
+	_ = grouperror.Walk(err, func(n grouperror.Node) error {
+	    fmt.Printf("%s%q %s\n", strings.Repeat("  ", n.Depth), n.Prefix, n.Err)
+	    return nil
+	})
+*/
+func Walk(err error, fn func(Node) error) error {
+	if err == nil {
+		return nil
+	}
+
+	if err := walk(err, 0, "", nil, fn); err != nil && !errors.Is(err, SkipGroup) && !errors.Is(err, SkipAll) {
+		return err
+	}
+
+	return nil
+}
+
+func walk(err error, depth int, prefix string, path Path, fn func(Node) error) error {
+	g, ok := groupOf(err)
+	if !ok {
+		return fn(Node{
+			Depth:      depth,
+			FullPrefix: prefix,
+			Path:       path,
+			Err:        err,
+		})
+	}
+
+	n := Node{
+		Depth:      depth,
+		Prefix:     g.prefix,
+		FullPrefix: prefix + g.prefix,
+		Path:       path.join(g.path),
+		Group:      true,
+		Err:        err,
+	}
+
+	if err := fn(n); err != nil {
+		if errors.Is(err, SkipGroup) {
+			return nil
+		}
+
+		return err
+	}
+
+	for _, x := range g.errors {
+		if err := walk(x, depth+1, n.FullPrefix, n.Path, fn); err != nil {
+			if errors.Is(err, SkipGroup) {
+				return nil
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
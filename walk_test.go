@@ -0,0 +1,182 @@
+// Copyright (c) 2023–present Bartłomiej Krukowski
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grouperror_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gontainer/grouperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func walkLines(t *testing.T, err error, fn func(grouperror.Node) error) []string {
+	t.Helper()
+
+	var lines []string
+
+	walkErr := grouperror.Walk(err, func(n grouperror.Node) error {
+		kind := "leaf"
+		if n.Group {
+			kind = "group"
+		}
+
+		lines = append(lines, fmt.Sprintf(
+			"%s%s %q %q %q %q",
+			strings.Repeat("  ", n.Depth),
+			kind,
+			n.Prefix,
+			n.FullPrefix,
+			n.Path.String(),
+			n.Err.Error(),
+		))
+
+		return fn(n)
+	})
+	require.NoError(t, walkErr)
+
+	return lines
+}
+
+//nolint:goerr113
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	group := grouperror.Prefix(
+		"validation: ",
+		grouperror.PrefixPath(
+			grouperror.Path{grouperror.Field("person")},
+			errors.New("invalid name"),
+			errors.New("invalid age"),
+		),
+		io.EOF,
+		grouperror.Prefix("address: ", errors.New("invalid zip code")),
+	)
+
+	t.Run("Nil", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, grouperror.Walk(nil, func(grouperror.Node) error {
+			return errors.New("unexpected call")
+		}))
+	})
+
+	t.Run("Single error", func(t *testing.T) {
+		t.Parallel()
+
+		var nodes []grouperror.Node
+
+		require.NoError(t, grouperror.Walk(io.EOF, func(n grouperror.Node) error {
+			nodes = append(nodes, n)
+
+			return nil
+		}))
+
+		assert.Equal(t, []grouperror.Node{{Err: io.EOF}}, nodes)
+	})
+
+	t.Run("All nodes", func(t *testing.T) {
+		t.Parallel()
+
+		lines := walkLines(t, group, func(grouperror.Node) error {
+			return nil
+		})
+
+		expected := []string{
+			`group "validation: " "validation: " "" "validation: person: invalid name\nvalidation: person: invalid age\nvalidation: EOF\nvalidation: address: invalid zip code"`,
+			`  group "person: " "validation: person: " "person" "person: invalid name\nperson: invalid age"`,
+			`    leaf "" "validation: person: " "person" "invalid name"`,
+			`    leaf "" "validation: person: " "person" "invalid age"`,
+			`  leaf "" "validation: " "" "EOF"`,
+			`  group "address: " "validation: address: " "" "address: invalid zip code"`,
+			`    leaf "" "validation: address: " "" "invalid zip code"`,
+		}
+
+		assert.Equal(t, expected, lines)
+	})
+
+	t.Run("SkipGroup", func(t *testing.T) {
+		t.Parallel()
+
+		lines := walkLines(t, group, func(n grouperror.Node) error {
+			if n.Prefix == "person: " {
+				return grouperror.SkipGroup
+			}
+
+			return nil
+		})
+
+		assert.Len(t, lines, 5)
+		assert.True(t, strings.HasPrefix(lines[2], `  leaf "" "validation: " "" "EOF"`))
+	})
+
+	t.Run("SkipGroup (leaf)", func(t *testing.T) {
+		t.Parallel()
+
+		lines := walkLines(t, group, func(n grouperror.Node) error {
+			if !n.Group && n.Err.Error() == "invalid name" {
+				return grouperror.SkipGroup
+			}
+
+			return nil
+		})
+
+		assert.Len(t, lines, 6)
+		assert.True(t, strings.HasPrefix(lines[3], `  leaf "" "validation: " "" "EOF"`))
+	})
+
+	t.Run("SkipAll", func(t *testing.T) {
+		t.Parallel()
+
+		lines := walkLines(t, group, func(n grouperror.Node) error {
+			if n.Err == io.EOF { //nolint:errorlint
+				return grouperror.SkipAll
+			}
+
+			return nil
+		})
+
+		assert.Len(t, lines, 5)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		t.Parallel()
+
+		errStop := errors.New("stop")
+		calls := 0
+
+		err := grouperror.Walk(group, func(grouperror.Node) error {
+			calls++
+			if calls == 3 {
+				return errStop
+			}
+
+			return nil
+		})
+
+		assert.Same(t, errStop, err)
+		assert.Equal(t, 3, calls)
+	})
+}